@@ -35,6 +35,7 @@ FEATURES:
 - AIAd_execution_flow.mmd.md - How functions execute at runtime
 - AIAd_function_dependencies.mmd.md - What to build first
 - AIAd_project_building_guide.md - Complete step-by-step guide
+- AIAd_user_journey.mmd.md - Register, log in, create and view, for product/UX docs
 
 ===============================================================================
 */
@@ -45,11 +46,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 )
 
 // AIAd_WriteFunctionFlowAnalysis generates comprehensive AI advisor function flow analysis diagrams.
 // This is the main orchestrator function that calls all individual AI advisor diagram functions.
-func AIAd_WriteFunctionFlowAnalysis(outDir string) error {
+// structure is the project scan (nil when none ran) and adds the detected background workers.
+func AIAd_WriteFunctionFlowAnalysis(outDir string, structure *ProjectStructure) error {
 	fmt.Println("🎯 Generating AI Advisor Function Flow Analysis...")
 
 	// Generate development sequence diagram
@@ -59,7 +63,7 @@ func AIAd_WriteFunctionFlowAnalysis(outDir string) error {
 	fmt.Println("✅ Generated AIAd_development_sequence.mmd.md")
 
 	// Generate execution flow diagram
-	if err := AIAd_WriteExecutionFlowDiagram(outDir); err != nil {
+	if err := AIAd_WriteExecutionFlowDiagram(outDir, structure); err != nil {
 		return fmt.Errorf("failed to write AI advisor execution flow diagram: %w", err)
 	}
 	fmt.Println("✅ Generated AIAd_execution_flow.mmd.md")
@@ -76,6 +80,16 @@ func AIAd_WriteFunctionFlowAnalysis(outDir string) error {
 	}
 	fmt.Println("✅ Generated AIAd_project_building_guide.md")
 
+	// Generate the user journey from the detected routes
+	root := ""
+	if structure != nil {
+		root = structure.Root
+	}
+	if err := AIAd_WriteUserJourneyDiagram(outDir, root); err != nil {
+		return fmt.Errorf("failed to write AI advisor user journey diagram: %w", err)
+	}
+	fmt.Println("✅ Generated AIAd_user_journey.mmd.md")
+
 	fmt.Println("🎉 AI Advisor Function Flow Analysis Complete!")
 	return nil
 }
@@ -187,7 +201,8 @@ flowchart TD
 
 // AIAd_WriteExecutionFlowDiagram writes a Mermaid diagram showing the order functions execute at runtime.
 // This diagram helps understand how the application works step by step during execution.
-func AIAd_WriteExecutionFlowDiagram(outDir string) error {
+// With a project scan, goroutine workers started at startup get their own subgraph.
+func AIAd_WriteExecutionFlowDiagram(outDir string, structure *ProjectStructure) error {
 	content := `# AI Advisor: Execution Flow - How Functions Execute at Runtime
 
 This diagram shows the **order in which functions execute** when the application runs.
@@ -264,12 +279,73 @@ flowchart TD
     WR3 --> WR4
     WR4 --> WR5
     
-` + "```\n"
+` + AIAd_initNodes(structure) + AIAd_backgroundWorkerNodes(structure) + "```\n"
 
 	path := filepath.Join(outDir, "AIAd_execution_flow.mmd.md")
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
+// AIAd_initNodes returns the execution flow lines for the scanned init functions: an
+// "Initialization (import-time)" group in the order Go runs them, leading into main()
+func AIAd_initNodes(structure *ProjectStructure) string {
+	if structure == nil {
+		return ""
+	}
+	inits := Existing_initFunctions(structure, Existing_ownFunctions(structure))
+	if len(inits) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("    subgraph Initialization[\"⚙️ INITIALIZATION (import-time, before main)\"]\n")
+	for i, fn := range inits {
+		b.WriteString(fmt.Sprintf("        IN%d[\"%d. %s.init()<br/>📍 %s:%d<br/>▶️ runs when the package is imported\"]:::initFn\n",
+			i+1, i+1, fn.Package, fn.File, fn.Line))
+	}
+	b.WriteString("    end\n")
+	b.WriteString("    \n    %% init functions run in import order, then main() starts\n")
+	for i := 1; i < len(inits); i++ {
+		b.WriteString(fmt.Sprintf("    IN%d --> IN%d\n", i, i+1))
+	}
+	b.WriteString(fmt.Sprintf("    IN%d ==>|then| E1\n", len(inits)))
+	b.WriteString("    classDef initFn fill:#e0f7fa,stroke:#00838f,stroke-width:2px\n")
+	return b.String()
+}
+
+// AIAd_backgroundWorkerNodes returns the execution flow lines for the long-running goroutines found
+// by the scan: one node per worker, linked from main() or NewApplication() when those start it
+func AIAd_backgroundWorkerNodes(structure *ProjectStructure) string {
+	if structure == nil {
+		return ""
+	}
+	var nodes, links strings.Builder
+	count := 0
+	for _, fn := range Existing_ownFunctions(structure) {
+		for _, worker := range fn.Workers {
+			count++
+			id := fmt.Sprintf("BW%d", count)
+			nodes.WriteString(fmt.Sprintf("        %s[\"⚙️ go %s<br/>📍 started in %s()<br/>%s:%d\"]:::worker\n",
+				id, Existing_mermaidLabel(worker), fn.Name, fn.File, fn.Line))
+			switch fn.Name {
+			case "main":
+				links.WriteString(fmt.Sprintf("    E1 -.->|go| %s\n", id))
+			case "NewApplication":
+				links.WriteString(fmt.Sprintf("    E2 -.->|go| %s\n", id))
+			}
+		}
+	}
+	if count == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("    subgraph BackgroundWorkers[\"⚙️ BACKGROUND WORKERS (long-running goroutines, not request-driven)\"]\n")
+	b.WriteString(nodes.String())
+	b.WriteString("    end\n")
+	b.WriteString("    \n    %% Background workers run alongside the request flows\n")
+	b.WriteString(links.String())
+	b.WriteString("    classDef worker fill:#ffe0b2,stroke:#e65100,stroke-width:2px,stroke-dasharray: 4 2\n")
+	return b.String()
+}
+
 // AIAd_WriteFunctionDependencyDiagram writes a diagram showing which functions depend on which other functions.
 // This diagram helps understand what to build first and the dependency relationships.
 func AIAd_WriteFunctionDependencyDiagram(outDir string) error {
@@ -526,10 +602,158 @@ This order ensures you never get stuck because you're always building on solid f
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
+// journeyAuthWords mark the registration and login routes; their resources are not the app's content
+var journeyAuthWords = []string{"register", "signup", "sign-up", "login", "signin", "sign-in", "logout", "token", "session", "auth", "users", "accounts"}
+
+// journeyIgnoredResources are operational routes that are not part of a user's path
+var journeyIgnoredResources = []string{"/", "health", "healthz", "ping", "status", "metrics", "version", "swagger", "docs"}
+
+// journeyStep is one task of the user journey; Route is nil when no endpoint was detected for it
+type journeyStep struct {
+	Section string
+	Task    string
+	Score   int // Mermaid journey satisfaction, 1-5
+	Route   *httpRoute
+}
+
+// AIAd_WriteUserJourneyDiagram writes a Mermaid journey of a typical user path - register, log in,
+// create and view - built from the auth and CRUD routes found under root. Steps without a matching
+// route keep a generic name, and with no matching route at all (or root == "") the whole diagram is
+// a template with a note saying so.
+func AIAd_WriteUserJourneyDiagram(outDir, root string) error {
+	var routes []httpRoute
+	if root != "" {
+		found, err := Existing_findRoutes(root)
+		if err != nil {
+			return fmt.Errorf("find routes: %w", err)
+		}
+		routes = found
+	}
+	steps := AIAd_journeySteps(routes)
+
+	detected := 0
+	for _, step := range steps {
+		if step.Route != nil {
+			detected++
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# AI Advisor: User Journey - From Sign-Up to First Use\n\n")
+	b.WriteString("A typical user's path through the application, for product and UX docs. ")
+	b.WriteString("Scores are the expected satisfaction at each step (1 = friction, 5 = delight).\n\n")
+	if detected == 0 {
+		b.WriteString("> **Note:** no registration, login or CRUD endpoints were detected, so this is a generic template. ")
+		b.WriteString("Routes registered like `r.Post(\"/users\", h.HandleRegisterUser)` are picked up automatically.\n\n")
+	}
+	b.WriteString("```mermaid\n")
+	b.WriteString("journey\n")
+	b.WriteString("    title User onboarding\n")
+	section := ""
+	for _, step := range steps {
+		if step.Section != section {
+			section = step.Section
+			b.WriteString(fmt.Sprintf("    section %s\n", section))
+		}
+		task := step.Task
+		if step.Route != nil {
+			task += " (" + step.Route.Method + " " + step.Route.Pattern + ")"
+		}
+		// A colon ends the task name in journey syntax, e.g. in chi's {id:[0-9]+}
+		b.WriteString(fmt.Sprintf("      %s: %d: User\n", strings.ReplaceAll(task, ":", " "), step.Score))
+	}
+	b.WriteString("```\n")
+
+	if detected > 0 {
+		b.WriteString("\n## Endpoints Behind Each Step\n\n")
+		b.WriteString("| Step | Endpoint | Handler | Location |\n")
+		b.WriteString("|------|----------|---------|----------|\n")
+		for _, step := range steps {
+			if step.Route == nil {
+				b.WriteString(fmt.Sprintf("| %s | ⚠️ not detected | | |\n", step.Task))
+				continue
+			}
+			r := step.Route
+			b.WriteString(fmt.Sprintf("| %s | `%s %s` | `%s` | `%s:%d` |\n", step.Task, r.Method, r.Pattern, r.Handler, r.File, r.Line))
+		}
+	}
+
+	path := filepath.Join(outDir, "AIAd_user_journey.mmd.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// AIAd_journeySteps picks the route behind each journey step: a POST that registers, a POST that
+// logs in, the first POST on a content resource, and a GET on that same resource (a detail view
+// such as /workouts/{id} when there is one)
+func AIAd_journeySteps(routes []httpRoute) []journeyStep {
+	hasAny := func(r httpRoute, words ...string) bool {
+		lower := strings.ToLower(r.Pattern)
+		for _, word := range words {
+			if strings.Contains(lower, word) {
+				return true
+			}
+		}
+		return false
+	}
+	find := func(match func(httpRoute) bool) *httpRoute {
+		for i := range routes {
+			if match(routes[i]) {
+				return &routes[i]
+			}
+		}
+		return nil
+	}
+
+	register := find(func(r httpRoute) bool {
+		return r.Method == "POST" && hasAny(r, "register", "signup", "sign-up")
+	})
+	if register == nil {
+		register = find(func(r httpRoute) bool {
+			resource := Existing_routeResource(r.Pattern)
+			return r.Method == "POST" && (resource == "users" || resource == "accounts") && !strings.Contains(r.Pattern, "{")
+		})
+	}
+	login := find(func(r httpRoute) bool {
+		return r.Method == "POST" && hasAny(r, "login", "signin", "sign-in", "token", "session", "auth")
+	})
+	isContent := func(r httpRoute) bool {
+		return !hasAny(r, journeyAuthWords...) && !slices.Contains(journeyIgnoredResources, Existing_routeResource(r.Pattern))
+	}
+	create := find(func(r httpRoute) bool { return r.Method == "POST" && isContent(r) })
+	resource := ""
+	if create != nil {
+		resource = Existing_routeResource(create.Pattern)
+	}
+	onResource := func(r httpRoute) bool {
+		return r.Method == "GET" && isContent(r) && (resource == "" || Existing_routeResource(r.Pattern) == resource)
+	}
+	view := find(func(r httpRoute) bool { return onResource(r) && strings.Contains(r.Pattern, "{") })
+	if view == nil {
+		view = find(onResource)
+	}
+	if resource == "" && view != nil {
+		resource = Existing_routeResource(view.Pattern)
+	}
+
+	item := "an item"
+	if singular := strings.TrimSuffix(resource, "s"); singular != "" {
+		item = "a " + singular
+		if strings.ContainsRune("aeiou", rune(singular[0])) {
+			item = "an " + singular
+		}
+	}
+	return []journeyStep{
+		{Section: "Sign up", Task: "Register an account", Score: 3, Route: register},
+		{Section: "Sign up", Task: "Log in", Score: 4, Route: login},
+		{Section: "First use", Task: "Create " + item, Score: 4, Route: create},
+		{Section: "First use", Task: "View " + item, Score: 5, Route: view},
+	}
+}
+
 // AIAd_WriteAllStructureDiagrams generates all AI advisor structure analysis diagrams
 func AIAd_WriteAllStructureDiagrams(outDir string) error {
 	fmt.Println("📊 Generating AI advisor function flow analysis...")
-	if err := AIAd_WriteFunctionFlowAnalysis(outDir); err != nil {
+	if err := AIAd_WriteFunctionFlowAnalysis(outDir, nil); err != nil {
 		return fmt.Errorf("AI advisor function flow analysis failed: %w", err)
 	}
 
@@ -539,7 +763,7 @@ func AIAd_WriteAllStructureDiagrams(outDir string) error {
 	}
 
 	fmt.Println("📊 Generating AI advisor execution flow diagram...")
-	if err := AIAd_WriteExecutionFlowDiagram(outDir); err != nil {
+	if err := AIAd_WriteExecutionFlowDiagram(outDir, nil); err != nil {
 		return fmt.Errorf("AI advisor execution flow diagram failed: %w", err)
 	}
 
@@ -0,0 +1,36 @@
+//go:build flowcharts
+
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+package main
+
+import "testing"
+
+// Sign up, log in, create, then read: each step takes the matching auth or CRUD route
+func TestJourneyStepsMatchRoutes(t *testing.T) {
+	routes := []httpRoute{
+		{Method: "GET", Pattern: "/health"},
+		{Method: "POST", Pattern: "/tokens/authentication"},
+		{Method: "POST", Pattern: "/users"},
+		{Method: "GET", Pattern: "/workouts"},
+		{Method: "POST", Pattern: "/workouts"},
+		{Method: "GET", Pattern: "/workouts/{id}"},
+	}
+	want := []string{"POST /users", "POST /tokens/authentication", "POST /workouts", "GET /workouts/{id}"}
+	steps := AIAd_journeySteps(routes)
+	if len(steps) != len(want) {
+		t.Fatalf("got %d journey steps, want %d: %+v", len(steps), len(want), steps)
+	}
+	for i, step := range steps {
+		if step.Route == nil || step.Route.Method+" "+step.Route.Pattern != want[i] {
+			t.Errorf("journey step %q should use %s, got %+v", step.Task, want[i], step.Route)
+		}
+	}
+}
@@ -0,0 +1,463 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+ANONYMIZER - SHAREABLE DIAGRAMS WITHOUT PROPRIETARY NAMES
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file rewrites the generated outputs of a run so that real
+             function, type, package and source file names are replaced by
+             stable pseudonyms (Func_01, Type_A, Pkg_A, File_01), the module
+             path by example.com/anonymized and the project's absolute path
+             by ".". The rewrite happens after every generator has finished,
+             so all relationships drawn from the real names stay intact - only
+             the labels change. Every text output is rewritten (Markdown,
+             Mermaid, HTML, SVG text, JSON, DOT, PlantUML and the SARIF file),
+             and the per-file diagrams, package READMEs and per-package
+             inventories are renamed, links included.
+
+TO USE THIS FILE:
+1. Run with -anonymize
+2. Call Anonymize_RewriteOutputs() after the diagrams are written
+3. Keep mapping.json private - it is the key to de-anonymize
+
+FEATURES:
+- mapping.json - Pseudonym -> real name mapping for internal use
+- Names made of several words (CreateUser, user_store) are replaced wherever
+  they appear as a whole word
+- Single-word names (Open, User, store) are also ordinary words, so in prose
+  formats they are replaced only where they are used as an identifier:
+  qualified (pkg.Name, *Name, dir/name), called (Name()), quoted, backticked,
+  a whole table cell or a node label. "Store Layer" stays readable, but a
+  single-word name used inside a sentence is left as written
+- JSON and SARIF string values and PlantUML sources are data, not prose: every
+  whole-word match is replaced there (JSON keys are left alone)
+
+NOTES:
+- Existing_function_set.json is anonymized too, so the next run's changelog
+  compares against pseudonyms; run without -anonymize once to reset it
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// anonymizedModulePath replaces the project's module path
+const anonymizedModulePath = "example.com/anonymized"
+
+// AnonymizeMapping maps pseudonyms back to the real names they replaced
+type AnonymizeMapping struct {
+	Functions map[string]string `json:"functions"`
+	Types     map[string]string `json:"types"`
+	Packages  map[string]string `json:"packages"`
+	Files     map[string]string `json:"files"` // source file names without .go
+}
+
+// Anonymize_BuildMapping assigns stable pseudonyms to every function, type, package and source file.
+// Names are sorted first so the same project always yields the same pseudonyms; a name used in
+// several roles keeps the pseudonym of the first (types, then functions, packages, files).
+func Anonymize_BuildMapping(structure *ProjectStructure) AnonymizeMapping {
+	mapping := AnonymizeMapping{
+		Functions: make(map[string]string),
+		Types:     make(map[string]string),
+		Packages:  make(map[string]string),
+		Files:     make(map[string]string),
+	}
+
+	typeSet := make(map[string]bool)
+	funcSet := make(map[string]bool)
+	pkgSet := make(map[string]bool)
+	fileSet := make(map[string]bool)
+	for _, fn := range structure.Functions {
+		// main and init are language entry points, not proprietary names
+		if fn.Name != "main" && fn.Name != "init" {
+			funcSet[fn.Name] = true
+		}
+		if fn.Receiver != "" {
+			typeSet[fn.Receiver] = true
+		}
+	}
+	// Types without methods still appear in the type report
+	for _, t := range structure.Types {
+		typeSet[t.Name] = true
+	}
+	for pkg := range structure.Packages {
+		if pkg != "main" {
+			pkgSet[pkg] = true
+		}
+	}
+	for _, file := range structure.Files {
+		if name := strings.TrimSuffix(path.Base(filepath.ToSlash(file)), ".go"); name != "main" {
+			fileSet[name] = true
+		}
+	}
+
+	taken := make(map[string]bool)
+	assign := func(set map[string]bool, into map[string]string, pseudonym func(i int) string) {
+		names := make([]string, 0, len(set))
+		for name := range set {
+			if !taken[name] {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for i, name := range names {
+			into[pseudonym(i)] = name
+			taken[name] = true
+		}
+	}
+	assign(typeSet, mapping.Types, func(i int) string { return "Type_" + anonymizeLetters(i) })
+	assign(funcSet, mapping.Functions, func(i int) string { return fmt.Sprintf("Func_%02d", i+1) })
+	assign(pkgSet, mapping.Packages, func(i int) string { return "Pkg_" + anonymizeLetters(i) })
+	assign(fileSet, mapping.Files, func(i int) string { return fmt.Sprintf("File_%02d", i+1) })
+
+	return mapping
+}
+
+// anonymizeLetters converts 0, 1, ... 25, 26 into A, B, ... Z, AA
+func anonymizeLetters(i int) string {
+	s := ""
+	for i >= 0 {
+		s = string(rune('A'+i%26)) + s
+		i = i/26 - 1
+	}
+	return s
+}
+
+// anonymizer rewrites text with the real -> pseudonym replacements of one mapping
+type anonymizer struct {
+	replacements map[string]string
+	plain        map[string]bool   // single-word names, replaced only where used as an identifier
+	keys         map[string]string // package and file names, which also key JSON maps
+	pattern      *regexp.Regexp    // every real name as a whole word, longest first
+	literal      *strings.Replacer
+}
+
+// anonymizePlainWord matches names that are also ordinary words: Open, User, store, OPEN
+var anonymizePlainWord = regexp.MustCompile(`^(?:[A-Z]?[a-z]+|[A-Z]+)$`)
+
+// newAnonymizer prepares the replacements; literal pairs (paths, renamed file names) are applied first
+func newAnonymizer(mapping AnonymizeMapping, literal []string) *anonymizer {
+	a := &anonymizer{replacements: make(map[string]string), plain: make(map[string]bool), keys: make(map[string]string)}
+	for _, group := range []map[string]string{mapping.Packages, mapping.Files} {
+		for pseudo, real := range group {
+			a.keys[real] = pseudo
+		}
+	}
+	for _, group := range []map[string]string{mapping.Types, mapping.Functions, mapping.Packages, mapping.Files} {
+		for pseudo, real := range group {
+			plain := anonymizePlainWord.MatchString(real)
+			for _, variant := range [][2]string{{real, pseudo}, {strings.ToUpper(real), strings.ToUpper(pseudo)}} {
+				if _, ok := a.replacements[variant[0]]; !ok {
+					a.replacements[variant[0]] = variant[1]
+					a.plain[variant[0]] = plain
+				}
+			}
+		}
+	}
+	names := make([]string, 0, len(a.replacements))
+	for name := range a.replacements {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) > len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	if len(names) > 0 {
+		a.pattern = regexp.MustCompile(`\b(` + strings.Join(names, "|") + `)\b`)
+	}
+	a.literal = strings.NewReplacer(literal...)
+	return a
+}
+
+// text rewrites s; with prose set, single-word names are only replaced in identifier positions
+func (a *anonymizer) text(s string, prose bool) string {
+	s = a.literal.Replace(s)
+	if a.pattern == nil {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, m := range a.pattern.FindAllStringIndex(s, -1) {
+		name := s[m[0]:m[1]]
+		if prose && a.plain[name] && !anonymizeIdentifierAt(s, m[0], m[1]) {
+			continue
+		}
+		b.WriteString(s[last:m[0]])
+		b.WriteString(a.replacements[name])
+		last = m[1]
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// anonymizeIdentifierAt reports whether the word s[i:j] is used as an identifier rather than prose
+func anonymizeIdentifierAt(s string, i, j int) bool {
+	var prev, next, after byte
+	if i > 0 {
+		prev = s[i-1]
+	}
+	if j < len(s) {
+		next = s[j]
+	}
+	if j+1 < len(s) {
+		after = s[j+1]
+	}
+	isLetter := func(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+	switch {
+	case prev == '.' || prev == '*' || prev == '/' || next == '(' || next == '/':
+		return true // pkg.Name, *Name, dir/name, Name(), name/
+	case next == '.' && isLetter(after):
+		return true // Name.Method, name.go
+	case strings.ContainsRune("`\"'>[(", rune(prev)) && strings.ContainsRune("`\"'<])", rune(next)):
+		return true // `Name`, "Name", >Name<, ["Name<br/>, (Name)
+	case strings.HasSuffix(s[:i], "| ") && strings.HasPrefix(s[j:], " |"):
+		return true // a whole Markdown table cell
+	case anonymizeLabelPrefix.MatchString(s[max(0, i-12):i]):
+		return true // "302. Name", "Package: name", "📦 name"
+	}
+	return false
+}
+
+// anonymizeLabelPrefix matches the text the diagrams put right before a name: a step number,
+// a "Package:" heading or the package emoji
+var anonymizeLabelPrefix = regexp.MustCompile(`(?:\d\. |Package: |📦 )$`)
+
+// anonymizeJSONString matches a JSON string literal and, for object keys, the colon after it
+var anonymizeJSONString = regexp.MustCompile(`"(?:[^"\\]|\\.)*"(\s*:)?`)
+
+// json rewrites the string values of a JSON document (data, so every match) and leaves its keys alone
+func (a *anonymizer) json(s string) string {
+	return anonymizeJSONString.ReplaceAllStringFunc(s, func(lit string) string {
+		if strings.HasSuffix(lit, ":") {
+			// Keys are field names, except in maps keyed by package or file name
+			key := strings.TrimRight(lit, " \t\r\n:")
+			if pseudo, ok := a.keys[strings.Trim(key, `"`)]; ok {
+				return `"` + pseudo + `"` + lit[len(key):]
+			}
+			return lit
+		}
+		return a.text(lit, false)
+	})
+}
+
+// path rewrites the project-derived names in an output file name; '_' separates words here
+func (a *anonymizer) path(rel string) string {
+	dir, base := path.Split(rel)
+	var b strings.Builder
+	for i := 0; i < len(base); {
+		if i == 0 || !anonymizeAlnum(base[i-1]) {
+			if name, pseudo := a.pathTokenAt(base[i:]); name != "" {
+				b.WriteString(pseudo)
+				i += len(name)
+				continue
+			}
+		}
+		b.WriteByte(base[i])
+		i++
+	}
+	return dir + b.String()
+}
+
+// pathTokenAt returns the longest real name starting s and ending at a word separator
+func (a *anonymizer) pathTokenAt(s string) (string, string) {
+	best := ""
+	for name := range a.replacements {
+		if len(name) > len(best) && strings.HasPrefix(s, name) && (len(s) == len(name) || !anonymizeAlnum(s[len(name)])) {
+			best = name
+		}
+	}
+	return best, a.replacements[best]
+}
+
+// anonymizeAlnum reports whether c is an ASCII letter or digit
+func anonymizeAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// anonymizeRenamed reports whether an output's file name is built from project names:
+// per-file diagrams, package READMEs and per-package inventories (not their index pages)
+func anonymizeRenamed(rel string) bool {
+	dir, base := path.Split(rel)
+	switch {
+	case base == "index.md" || base == "inventory_index.md":
+		return false
+	case dir == "per_file/" || dir == "readmes/":
+		return true
+	}
+	return dir == "" && strings.HasPrefix(base, "inventory_")
+}
+
+// anonymizeTextFile reports whether data is text that can be rewritten (UTF-8, no NUL bytes)
+func anonymizeTextFile(data []byte) bool {
+	return utf8.Valid(data) && !bytes.ContainsRune(data, 0)
+}
+
+// Anonymize_RewriteOutputs replaces real names in every text output under outDir (and in the
+// extra files, e.g. a SARIF report written elsewhere), renames outputs named after them and
+// writes mapping.json next to them.
+func Anonymize_RewriteOutputs(outDir string, structure *ProjectStructure, extraFiles ...string) error {
+	if structure == nil {
+		return fmt.Errorf("anonymize: no project structure available")
+	}
+	mapping := Anonymize_BuildMapping(structure)
+
+	// Absolute paths and the module path go first, as literal strings, longest first
+	var literal []string
+	if modRoot, ok := findModuleRoot(structure.Root); ok {
+		if mod := readModulePath(filepath.Join(modRoot, "go.mod")); mod != "" {
+			literal = append(literal, mod, anonymizedModulePath)
+		}
+	}
+	if structure.Root != "" {
+		literal = append(literal, structure.Root, ".")
+		if slash := filepath.ToSlash(structure.Root); slash != structure.Root {
+			literal = append(literal, slash, ".")
+		}
+	}
+
+	// Collect the outputs and work out the renames before rewriting any content
+	var files []string
+	err := filepath.Walk(outDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("anonymize outputs: %w", err)
+	}
+	// Go doc comments open with the function's name, as a sentence subject
+	for pseudo, real := range mapping.Functions {
+		for _, fn := range structure.Functions {
+			if fn.Name == real && strings.HasPrefix(fn.Doc, real+" ") {
+				literal = append(literal, fn.Doc, pseudo+strings.TrimPrefix(fn.Doc, real))
+			}
+		}
+	}
+
+	names := newAnonymizer(mapping, nil)
+	renames := make(map[string]string)
+	for _, p := range files {
+		if rel := Existing_relSlash(outDir, p); anonymizeRenamed(rel) {
+			if to := names.path(rel); to != rel {
+				renames[p] = filepath.Join(outDir, filepath.FromSlash(to))
+				// Links to the file use its base name (index pages sit next to it)
+				literal = append(literal, path.Base(rel), path.Base(to))
+			}
+		}
+	}
+	a := newAnonymizer(mapping, anonymizeLongestFirst(literal))
+
+	for _, p := range extraFiles {
+		if !slices.Contains(files, p) {
+			files = append(files, p)
+		}
+	}
+	rewritten := 0
+	for _, p := range files {
+		if filepath.Base(p) == "mapping.json" || filepath.Base(p) == manifestName {
+			continue
+		}
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("anonymize %s: %w", p, err)
+		}
+		if !anonymizeTextFile(content) {
+			continue
+		}
+		var updated string
+		switch ext := strings.ToLower(filepath.Ext(p)); {
+		case ext == ".json" || ext == ".sarif" || slices.Contains(extraFiles, p):
+			updated = a.json(string(content))
+		case ext == ".puml":
+			updated = a.text(string(content), false)
+		case ext == ".svg":
+			// Only touch text nodes so element and attribute names survive
+			updated = anonymizeSVGText.ReplaceAllStringFunc(string(content), func(s string) string { return a.text(s, true) })
+		default:
+			updated = a.text(string(content), true)
+		}
+		target := p
+		if to, ok := renames[p]; ok {
+			target = to
+		}
+		if updated == string(content) && target == p {
+			continue
+		}
+		info, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, []byte(updated), info.Mode()); err != nil {
+			return err
+		}
+		if target != p {
+			if err := os.Remove(p); err != nil {
+				return err
+			}
+		}
+		rewritten++
+	}
+
+	data, err := json.MarshalIndent(mapping, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal mapping: %w", err)
+	}
+	mappingPath := filepath.Join(outDir, "mapping.json")
+	if err := os.WriteFile(mappingPath, data, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("🕶️  Anonymized %d files (%d functions, %d types, %d packages, %d source files; %d renamed)\n",
+		rewritten, len(mapping.Functions), len(mapping.Types), len(mapping.Packages), len(mapping.Files), len(renames))
+	fmt.Printf("   Keep %s private - it maps pseudonyms back to real names\n", mappingPath)
+	return nil
+}
+
+// anonymizeSVGText matches the text between SVG tags
+var anonymizeSVGText = regexp.MustCompile(`>[^<]+<`)
+
+// anonymizeLongestFirst orders old/new pairs by descending old length so strings.Replacer
+// rewrites a long path before a shorter one it contains
+func anonymizeLongestFirst(pairs []string) []string {
+	type pair struct{ old, new string }
+	list := make([]pair, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		list = append(list, pair{pairs[i], pairs[i+1]})
+	}
+	sort.SliceStable(list, func(i, j int) bool { return len(list[i].old) > len(list[j].old) })
+	out := make([]string, 0, len(pairs))
+	for _, p := range list {
+		out = append(out, p.old, p.new)
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+//go:build flowcharts
+
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+package main
+
+import "testing"
+
+func testAnonymizer() *anonymizer {
+	return newAnonymizer(AnonymizeMapping{
+		Functions: map[string]string{"Func_01": "CreateUser", "Func_02": "Open"},
+		Types:     map[string]string{"Type_A": "Store"},
+		Packages:  map[string]string{"Pkg_A": "store"},
+		Files:     map[string]string{"File_01": "user_store"},
+	}, nil)
+}
+
+func TestAnonymizeProseKeepsOrdinaryWords(t *testing.T) {
+	a := testAnonymizer()
+	tests := []struct{ in, want string }{
+		{"Store Layer: CreateUser", "Store Layer: Func_01"},                            // compound names everywhere
+		{"store.Open() in store/user_store.go", "Pkg_A.Func_02() in Pkg_A/File_01.go"}, // qualified, called, paths
+		{"`Store` and \"Open\"", "`Type_A` and \"Func_02\""},
+		{"| Open |", "| Func_02 |"},
+		{"F1[\"1. Open<br/>x\"]", "F1[\"1. Func_02<br/>x\"]"},
+		{"## Package: store", "## Package: Pkg_A"},
+		{"Open the store to see it", "Open the store to see it"}, // prose
+	}
+	for _, tt := range tests {
+		if got := a.text(tt.in, true); got != tt.want {
+			t.Errorf("text(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAnonymizeJSONKeepsFieldNames(t *testing.T) {
+	a := testAnonymizer()
+	in := `{"Store": "Open the store", "store": ["user_store.go"]}`
+	want := `{"Store": "Func_02 the Pkg_A", "Pkg_A": ["File_01.go"]}`
+	if got := a.json(in); got != want {
+		t.Errorf("json() = %s, want %s", got, want)
+	}
+}
+
+func TestAnonymizePathRenamesUnderscoreTokens(t *testing.T) {
+	a := testAnonymizer()
+	if got := a.path("per_file/internal_store_user_store.mmd.md"); got != "per_file/internal_Pkg_A_File_01.mmd.md" {
+		t.Errorf("path() = %q", got)
+	}
+	if anonymizeRenamed("per_file/index.md") || !anonymizeRenamed("readmes/store.md") || anonymizeRenamed("Existing_data_flow.mmd.md") {
+		t.Error("anonymizeRenamed picks the wrong files")
+	}
+}
@@ -54,14 +54,22 @@ go run .
 */
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
+	"html"
+	"io"
 	"log"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // main
@@ -78,39 +86,376 @@ type FlowchartOptions struct {
 	IncludeTests  bool   // include tests in go-callvis graph
 	GenerateUML   bool   // generate PlantUML class diagram if goplantuml is available
 	Comprehensive bool   // also generate expanded charts under ComprehensiveCharts
+	Anonymize     bool   // replace function/type/package/file names with stable pseudonyms in all outputs
+	SARIF         string // write lint findings as SARIF 2.1.0 to this path ("" = off)
+	SkipOpen      bool   // write the HTML charts and index.html but open nothing in the browser
+	DocsOnly      bool   // pure-Go generators only: never run external tools, also write DIAGRAMS.md
+}
+
+// callvisGroups are the -group tokens go-callvis accepts
+var callvisGroups = []string{"pkg", "type"}
+
+// normalizeGroup checks a comma-separated -group value against callvisGroups and returns it
+// without spaces, so a typo fails before go-callvis runs instead of midway through it
+func normalizeGroup(value string) (string, error) {
+	if strings.TrimSpace(value) == "" {
+		return "", nil
+	}
+	var tokens []string
+	for _, token := range strings.Split(value, ",") {
+		token = strings.TrimSpace(token)
+		if !slices.Contains(callvisGroups, token) {
+			return "", fmt.Errorf("%q is not a valid group (valid: %s, comma-separated)", token, strings.Join(callvisGroups, ", "))
+		}
+		tokens = append(tokens, token)
+	}
+	return strings.Join(tokens, ","), nil
+}
+
+// focusRegex and ignoreRegex are the compiled -focus and -ignore patterns (nil when unset); the
+// scanned functions behind every pure-Go report are filtered with them as well as go-callvis
+var focusRegex, ignoreRegex *regexp.Regexp
+
+// compileCallvisPatterns compiles the -focus and -ignore regexes of opts; an empty pattern gives nil
+func compileCallvisPatterns(opts FlowchartOptions) (focus, ignore *regexp.Regexp, err error) {
+	if opts.Focus != "" {
+		if focus, err = regexp.Compile(opts.Focus); err != nil {
+			return nil, nil, fmt.Errorf("invalid -focus regex: %w", err)
+		}
+	}
+	if opts.Ignore != "" {
+		if ignore, err = regexp.Compile(opts.Ignore); err != nil {
+			return nil, nil, fmt.Errorf("invalid -ignore regex: %w", err)
+		}
+	}
+	return focus, ignore, nil
+}
+
+// validateCallvisOptions checks the go-callvis settings of opts before any tool runs
+func validateCallvisOptions(opts FlowchartOptions) error {
+	if _, err := normalizeGroup(opts.Group); err != nil {
+		return fmt.Errorf("-group: %w", err)
+	}
+	_, _, err := compileCallvisPatterns(opts)
+	return err
 }
 
 func main() {
-	outDir := flag.String("out", "BTFlowcharts", "output directory for generated graphs")
-	root := flag.String("root", "", "project root (defaults to current working directory)")
+	outDir := flag.String("out", defaultOutDir, "output directory for generated graphs (relative to the module root, or absolute; default $"+outDirEnv+")")
+	var roots rootList
+	flag.Var(&roots, "root", "project root (defaults to current working directory); repeat or comma-separate to diagram several services into <out>/<service>/")
 	// Detail configuration flags
 	noStd := flag.Bool("nostd", true, "exclude Go stdlib from function graph")
-	group := flag.String("group", "pkg,type", "grouping for function graph (e.g., pkg,type)")
-	focus := flag.String("focus", "", "optional focus (pkg or func regex) for function graph")
-	ignore := flag.String("ignore", "", "optional ignore regex for function graph")
+	group := flag.String("group", "pkg,type", "grouping for the go-callvis graphs: comma-separated pkg and/or type (\"\" = none)")
+	focus := flag.String("focus", "", "optional focus regex (package path or function) for go-callvis and the scanned-function reports")
+	ignore := flag.String("ignore", "", "optional ignore regex (package path or function) for go-callvis and the scanned-function reports")
 	tests := flag.Bool("tests", true, "include test files in function graph")
 	uml := flag.Bool("uml", true, "generate PlantUML class diagram if goplantuml is installed")
 	comprehensive := flag.Bool("comprehensive", true, "also generate expanded charts under ComprehensiveCharts")
 	interactive := flag.Bool("interactive", false, "run in interactive mode with menu")
+	anonymize := flag.Bool("anonymize", false, "replace function, type, package and source file names in every output with pseudonyms (writes mapping.json)")
+	selftest := flag.Bool("selftest", false, "generate against an embedded sample project and check the outputs (PASS/FAIL)")
+	task := flag.String("task", "", "run a single task instead of the full pipeline (merge, evaluate, compare-to-model, imports, html)")
+	inputs := flag.String("inputs", "", "comma-separated Existing_structure.json files for -task merge")
+	mermaidVer := flag.String("mermaid-version", defaultMermaidVersion, "Mermaid.js version pinned in generated HTML (\"latest\" for unpinned); newer diagram types are skipped on older versions")
+	erdSample := flag.Bool("erd-sample", false, "also write the canned EXAMPLE ERDs (their tables are invented, not your schema)")
+	failOnScore := flag.Int("fail-on-score", 0, "exit non-zero when the ProjectEvaluator final score is below N (0 = off)")
+	profile := flag.Bool("profile", false, "time each step and print a summary table at the end")
+	profileCPU := flag.Bool("profile-cpu", false, "with -profile, also write a pprof CPU profile to <out>/cpu.pprof")
+	splitInv := flag.Bool("split-inventory", false, "write inventory_<pkg>.md per package plus inventory_index.md instead of one Existing_function_inventory.md")
+	sarif := flag.String("sarif", "", "write unused-function, SQL injection and unwired-handler findings as SARIF 2.1.0 to this file")
+	typed := flag.Bool("typed", false, "resolve function dependency edges with go/types (needs a buildable project; falls back to name heuristics)")
+	treeDirsFlag := flag.String("tree-dirs", strings.Join(treeDirs, ","), "comma-separated top-level directories for the file tree and architecture diagram")
+	apiOnly := flag.Bool("api-only", false, "only write API_REFERENCE.md: exported functions, types and methods grouped by package")
+	handlersOnly := flag.Bool("handlers-only", false, "only write the handler dependency diagram: HTTP handlers and the store methods they reach")
+	offline := flag.Bool("offline", false, "embed JavaScript in generated HTML instead of loading it from a CDN (package treemap)")
+	minPurpose := flag.String("min-purpose-confidence", purposeUnknown, "hide inventory purposes below this source: unknown (show all), heuristic (hide \"General function\"), doc (doc comments only)")
+	gitRef := flag.String("ref", "", "generate for a git tag, branch or commit via a temporary worktree (output under <out>/ref-<ref>)")
+	compareRuns := flag.Int("compare-runs", 0, "plot the ProjectEvaluator final score over the last N commits (each checked out into a temporary worktree, cached by commit SHA)")
+	linkBaseFlag := flag.String("link-base", "", "base URL for click-to-source links on dependency diagram nodes, e.g. https://github.com/you/repo/blob/main")
+	includeVendorFlag := flag.Bool("include-vendor", false, "also scan vendor/ (functions are tagged vendored, drawn dashed and left out of progress scores)")
+	flag.Func("exclude-dir", "glob (relative to the root, e.g. legacy/* or internal/gen) of directories every scan skips; repeatable", addExcludeDir)
+	strict := flag.Bool("strict", false, "treat every \"failed (continuing)\" warning as an error and exit non-zero (for CI)")
+	openAllFlag := flag.Bool("open-all", false, "open every generated chart in the browser instead of only index.html")
+	maxFuncLinesFlag := flag.Int("max-func-lines", defaultMaxFuncLines, "list functions longer than N lines in Existing_long_functions.md")
+	labelDetailFlag := flag.String("label-detail", labelFull, "node labels on the brain, connections and dependency diagrams: minimal (name), normal (name, file) or full (name, file, purpose)")
+	rawMermaidFlag := flag.Bool("raw-mermaid", false, "also write a plain .mmd (diagram body only, no fences or prose) next to every .mmd.md, for mmdc and editor plugins")
+	noManifestFlag := flag.Bool("no-manifest", false, "do not write manifest.json (every output file with its size and SHA-256) at the end of a run")
+	docsOnly := flag.Bool("docs-only", false, "pure-Go docs preset: no external tools, no browser, embedded JavaScript; writes the diagrams, index.html and DIAGRAMS.md")
+	serveAddr := flag.String("serve", "", "serve the charts on this address (e.g. localhost:8080), open the browser once and live-reload it on every code change")
+	lang := flag.String("lang", "en", "language for report text (en, fr); missing translations fall back to English")
 	flag.Parse()
+	if !flagPassed("out") {
+		if env := os.Getenv(outDirEnv); env != "" {
+			*outDir = env
+		}
+	}
+	if err := setLanguage(*lang); err != nil {
+		log.Fatalf("invalid -lang: %v", err)
+	}
+	if err := setMermaidVersion(*mermaidVer); err != nil {
+		log.Fatalf("invalid -mermaid-version: %v", err)
+	}
+	erdSampleEnabled = *erdSample
+	if err := setTreeDirs(*treeDirsFlag); err != nil {
+		log.Fatalf("invalid -tree-dirs: %v", err)
+	}
+	splitInventory = *splitInv
+	if err := setMinPurposeConfidence(*minPurpose); err != nil {
+		log.Fatalf("invalid -min-purpose-confidence: %v", err)
+	}
+	if err := setMaxFuncLines(*maxFuncLinesFlag); err != nil {
+		log.Fatalf("invalid -max-func-lines: %v", err)
+	}
+	if err := setLabelDetail(*labelDetailFlag); err != nil {
+		log.Fatalf("invalid -label-detail: %v", err)
+	}
+	if *compareRuns < 0 {
+		log.Fatalf("invalid -compare-runs %d: want a number of commits (0 = off)", *compareRuns)
+	}
+	if *compareRuns > 0 && (*gitRef != "" || *interactive || *serveAddr != "") {
+		log.Fatalf("-compare-runs cannot be combined with -ref, -interactive or -serve")
+	}
+	groupValue, err := normalizeGroup(*group)
+	if err != nil {
+		log.Fatalf("invalid -group: %v", err)
+	}
+	typedMode = *typed
+	offlineMode = *offline
+	includeVendor = *includeVendorFlag
+	openAll = *openAllFlag
+	strictMode = *strict
+	rawMermaid = *rawMermaidFlag
+	noManifest = *noManifestFlag
+	if *linkBaseFlag != "" {
+		if u, err := url.Parse(*linkBaseFlag); err != nil || u.Scheme == "" || u.Host == "" {
+			log.Fatalf("invalid -link-base %q: want an absolute URL such as https://github.com/you/repo/blob/main", *linkBaseFlag)
+		}
+		linkBase = *linkBaseFlag
+	}
 	opts := FlowchartOptions{
 		NoStdlib:      *noStd,
-		Group:         *group,
+		Group:         groupValue,
 		Focus:         *focus,
 		Ignore:        *ignore,
 		IncludeTests:  *tests,
 		GenerateUML:   *uml,
 		Comprehensive: *comprehensive,
+		Anonymize:     *anonymize,
+		SARIF:         *sarif,
+	}
+	if focusRegex, ignoreRegex, err = compileCallvisPatterns(opts); err != nil {
+		log.Fatal(err)
+	}
+	if *docsOnly {
+		if *interactive || *serveAddr != "" || *gitRef != "" || *compareRuns > 0 {
+			log.Fatalf("-docs-only cannot be combined with -interactive, -serve, -ref or -compare-runs")
+		}
+		applyDocsOnly(&opts)
 	}
 
-	if *interactive {
-		runInteractiveMode(*root, *outDir, opts)
+	if *selftest {
+		if err := SelfTest_Run(); err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
+
+	// Several roots: one pipeline per service plus a cross-service index
+	if len(roots) > 1 {
+		if *task != "" || *apiOnly || *handlersOnly || *gitRef != "" || *compareRuns > 0 || *interactive || *failOnScore > 0 || *serveAddr != "" {
+			log.Fatalf("several -root values only work with the full pipeline (not with -task, -api-only, -handlers-only, -ref, -compare-runs, -interactive, -fail-on-score or -serve)")
+		}
+		outAbs := resolveOutDir(projectRootOrWD(""), *outDir)
+		if *profile {
+			startProfiling()
+		}
+		failed := runMultiRoot(roots, outAbs, opts)
+		if !noManifest {
+			if err := writeRunManifest(outAbs); err != nil {
+				log.Fatalf("manifest: %v", err)
+			}
+		}
+		if failed > 0 {
+			log.Fatalf("%d service(s) failed - see %s", failed, filepath.Join(outAbs, "index.html"))
+		}
+		return
+	}
+	root := ""
+	if len(roots) == 1 {
+		root = roots[0]
+	}
+
+	// Resolve the output directory once; every task and generator writes under outAbs
+	projectRoot := projectRootOrWD(root)
+	outAbs := resolveOutDir(projectRoot, *outDir)
+
+	if *apiOnly {
+		if err := Existing_WriteAPIReference(outAbs, projectRoot); err != nil {
+			log.Fatalf("API reference failed: %v", err)
+		}
+		return
+	}
+	if *handlersOnly {
+		if err := ensureDir(outAbs); err != nil {
+			log.Fatalf("handler dependency diagram failed: %v", err)
+		}
+		structure, err := Existing_scanProject(projectRoot)
+		if err != nil {
+			log.Fatalf("project scan failed: %v", err)
+		}
+		if err := Existing_WriteHandlerDependencyDiagram(outAbs, structure); err != nil {
+			log.Fatalf("handler dependency diagram failed: %v", err)
+		}
+		fmt.Printf("✅ Generated %s\n", filepath.Join(outAbs, "Existing_function_dependencies_handlers.mmd.md"))
+		return
+	}
+
+	switch *task {
+	case "":
+	case "merge":
+		var files []string
+		for _, in := range strings.Split(*inputs, ",") {
+			if in = strings.TrimSpace(in); in != "" {
+				files = append(files, in)
+			}
+		}
+		if err := Existing_MergeStructures(files, outAbs); err != nil {
+			log.Fatalf("merge failed: %v", err)
+		}
+		return
+	case "evaluate":
+		if !runScoreGate(projectRoot, outAbs, *failOnScore) {
+			os.Exit(1)
+		}
+		return
+	case "compare-to-model":
+		if err := ClassModelBuilder_CompareToModel(projectRoot, outAbs); err != nil {
+			log.Fatalf("compare to model failed: %v", err)
+		}
+		return
+	case "imports":
+		if err := Existing_PrintImportEdges(os.Stdout, projectRoot); err != nil {
+			log.Fatalf("imports failed: %v", err)
+		}
+		return
+	case "html":
+		if err := runHTMLTask(outAbs); err != nil {
+			log.Fatalf("html failed: %v", err)
+		}
+		return
+	default:
+		log.Fatalf("unknown -task %q (supported: merge, evaluate, compare-to-model, imports, html)", *task)
+	}
+
+	stopCPU := func() {}
+	if *profile {
+		startProfiling()
+		if *profileCPU {
+			if stopCPU, err = startCPUProfile(outAbs); err != nil {
+				log.Fatalf("profile: %v", err)
+			}
+		}
+	}
+
+	scorePassed := true
+	if *compareRuns > 0 {
+		if err := runCompareRuns(projectRoot, outAbs, *compareRuns); err != nil {
+			log.Fatalf("compare runs failed: %v", err)
+		}
+	} else if *gitRef != "" {
+		if err := runAtGitRef(projectRoot, outAbs, *gitRef, opts); err != nil {
+			log.Fatalf("generation at %s failed: %v", *gitRef, err)
+		}
+	} else if *interactive {
+		runInteractiveMode(projectRoot, outAbs, opts)
+	} else if *serveAddr != "" {
+		if err := runServeMode(root, projectRoot, outAbs, *serveAddr, opts); err != nil {
+			log.Fatalf("serve failed: %v", err)
+		}
 	} else {
-		if err := BTFlowcharts(*root, *outDir, opts); err != nil {
+		if err := BTFlowcharts(root, outAbs, opts); err != nil {
 			log.Fatalf("flowchart generation failed: %v", err)
 		}
+		if *failOnScore > 0 {
+			scorePassed = runScoreGate(projectRoot, outAbs, *failOnScore)
+		}
 	}
 
+	// Manifest last, once every output is on disk: the assessment above and the finished CPU profile
+	stopCPU()
+	if !noManifest {
+		if err := writeRunManifest(outAbs); err != nil {
+			log.Fatalf("manifest: %v", err)
+		}
+	}
+	if !scorePassed {
+		os.Exit(1)
+	}
+}
+
+// defaultOutDir is where reports go when neither -out nor BT_OUT is set
+const defaultOutDir = "BTFlowcharts"
+
+// outDirEnv names the environment variable that sets the output directory when -out is not given
+const outDirEnv = "BT_OUT"
+
+// flagPassed reports whether a flag was set on the command line
+func flagPassed(name string) bool {
+	passed := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			passed = true
+		}
+	})
+	return passed
+}
+
+// resolveOutDir returns an absolute output directory: an absolute out is used verbatim
+// (e.g. one docs folder shared by several projects), a relative one goes under moduleRoot
+func resolveOutDir(moduleRoot, out string) string {
+	if out == "" {
+		out = defaultOutDir
+	}
+	if filepath.IsAbs(out) {
+		return filepath.Clean(out)
+	}
+	return filepath.Join(moduleRoot, out)
+}
+
+// projectRootOrWD resolves -root (default: working directory) to its enclosing module root
+func projectRootOrWD(root string) string {
+	if root == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			log.Fatalf("getwd: %v", err)
+		}
+		root = wd
+	}
+	if mr, ok := findModuleRoot(root); ok {
+		return mr
+	}
+	return root
+}
+
+// runScoreGate writes the comprehensive assessment of projectRoot and reports whether the score meets threshold
+func runScoreGate(projectRoot, outDir string, threshold int) bool {
+	if err := ensureDir(outDir); err != nil {
+		log.Fatalf("evaluation failed: %v", err)
+	}
+	status, err := ProjectEvaluator_WriteComprehensiveAssessment(projectRoot, outDir)
+	if err != nil {
+		log.Fatalf("evaluation failed: %v", err)
+	}
+	fmt.Printf("🏆 Final Score: %d/100 (%s)\n", status.FinalScore, status.Rating)
+	if err := ProjectEvaluator_CheckScore(status, threshold); err != nil {
+		fmt.Printf("❌ FAIL: %v\n", err)
+		return false
+	}
+	if threshold > 0 {
+		fmt.Printf("✅ PASS: score meets the required %d\n", threshold)
+	}
+	return true
 }
 
 // runInteractiveMode provides an interactive menu for chart generation
@@ -137,8 +482,12 @@ func runInteractiveMode(root, outDir string, opts FlowchartOptions) {
 
 		fmt.Print("\n🎯 Choose an option (1-12, 99) or press Enter to Regenerate HTML Charts: ")
 
-		var choice string
-		fmt.Scanln(&choice)
+		choice, ok := scanAnswer()
+		if !ok {
+			// Input exhausted (e.g. piped input ended): exit instead of re-printing the menu forever
+			fmt.Println("\n👋 Goodbye!")
+			return
+		}
 
 		// Default to "1" if empty input
 		if choice == "" {
@@ -160,55 +509,39 @@ func runInteractiveMode(root, outDir string, opts FlowchartOptions) {
 				fmt.Println("✅ Core charts generated successfully!")
 			}
 
-			// Generate Schema ERD (option 7)
-			fmt.Println("\n🗄️ Generating Schema ERD...")
-			// First scan the project to get current functions
+			// Scan once; options 7-10 below all work from the same structure
 			structure, err := Existing_scanProject(root)
 			if err != nil {
 				fmt.Printf("❌ Error scanning project: %v\n", err)
 			} else {
 				fmt.Printf("✅ Found %d functions across %d files\n", len(structure.Functions), len(structure.Files))
 
+				// Generate Schema ERD (option 7)
+				fmt.Println("\n🗄️ Generating Schema ERD...")
 				if err := generateSchemaSpyERD(root, outDir, structure); err != nil {
 					fmt.Printf("❌ Error generating Schema ERD: %v\n", err)
 				} else {
 					fmt.Println("✅ Schema ERD generated successfully!")
 				}
-			}
-
-			// Generate Existing Diagrams (option 8)
-			fmt.Println("\n📊 Generating Existing Diagrams (Current Project State Analysis)...")
-			if err := generateExistingDiagrams(root, outDir); err != nil {
-				fmt.Printf("❌ Error generating existing diagrams: %v\n", err)
-			} else {
-				fmt.Println("✅ Existing diagrams generated successfully!")
-			}
 
-			// Generate Theory to Reality Analysis (option 9)
-			fmt.Println("\n🔍 Generating Theory to Reality Analysis...")
-			// First scan the project to get current functions
-			structure, err = Existing_scanProject(root)
-			if err != nil {
-				fmt.Printf("❌ Error scanning project: %v\n", err)
-			} else {
-				fmt.Printf("✅ Found %d functions across %d files\n", len(structure.Functions), len(structure.Files))
+				// Generate Existing Diagrams (option 8)
+				fmt.Println("\n📊 Generating Existing Diagrams (Current Project State Analysis)...")
+				if err := generateExistingDiagrams(root, outDir, structure); err != nil {
+					fmt.Printf("❌ Error generating existing diagrams: %v\n", err)
+				} else {
+					fmt.Println("✅ Existing diagrams generated successfully!")
+				}
 
+				// Generate Theory to Reality Analysis (option 9)
+				fmt.Println("\n🔍 Generating Theory to Reality Analysis...")
 				if err := Theory2Reality_WriteAllAnalysis(outDir, structure); err != nil {
 					fmt.Printf("❌ Error generating theory to reality analysis: %v\n", err)
 				} else {
 					fmt.Println("✅ Theory to reality analysis generated successfully!")
 				}
-			}
-
-			// Generate Model to Reality Analysis (option 10)
-			fmt.Println("\n🔍 Generating Model to Reality Analysis...")
-			// First scan the project to get current functions
-			structure, err = Existing_scanProject(root)
-			if err != nil {
-				fmt.Printf("❌ Error scanning project: %v\n", err)
-			} else {
-				fmt.Printf("✅ Found %d functions across %d files\n", len(structure.Functions), len(structure.Files))
 
+				// Generate Model to Reality Analysis (option 10)
+				fmt.Println("\n🔍 Generating Model to Reality Analysis...")
 				if err := Theory2Reality_WriteAllAnalysis(outDir, structure); err != nil {
 					fmt.Printf("❌ Error generating model to reality analysis: %v\n", err)
 				} else {
@@ -220,7 +553,14 @@ func runInteractiveMode(root, outDir string, opts FlowchartOptions) {
 
 		case "3":
 			fmt.Println("\n🔍 Generating Project Scanner reports...")
-			if err := generateScannerReports(root, outDir); err != nil {
+			structure, err := Existing_scanProject(root)
+			if err != nil {
+				fmt.Printf("❌ Error scanning project: %v\n", err)
+				break
+			}
+			fmt.Printf("✅ Found %d functions across %d files\n", len(structure.Functions), len(structure.Files))
+
+			if err := generateScannerReports(outDir, structure); err != nil {
 				fmt.Printf("❌ Error generating scanner reports: %v\n", err)
 			} else {
 				fmt.Println("✅ Project scanner reports generated successfully!")
@@ -273,7 +613,14 @@ func runInteractiveMode(root, outDir string, opts FlowchartOptions) {
 			}
 		case "8":
 			fmt.Println("\n📊 Generating Existing Diagrams (Current Project State Analysis)...")
-			if err := generateExistingDiagrams(root, outDir); err != nil {
+			structure, err := Existing_scanProject(root)
+			if err != nil {
+				fmt.Printf("❌ Error scanning project: %v\n", err)
+				break
+			}
+			fmt.Printf("✅ Found %d functions across %d files\n", len(structure.Functions), len(structure.Files))
+
+			if err := generateExistingDiagrams(root, outDir, structure); err != nil {
 				fmt.Printf("❌ Error generating existing diagrams: %v\n", err)
 			} else {
 				fmt.Println("✅ Existing diagrams generated successfully!")
@@ -309,7 +656,7 @@ func runInteractiveMode(root, outDir string, opts FlowchartOptions) {
 			}
 		case "99":
 			fmt.Println("\n🔍 Starting Project Status Evaluation & Assessment...")
-			if err := ProjectEvaluator_WriteAllEvaluations(outDir); err != nil {
+			if err := ProjectEvaluator_WriteAllEvaluations(root, outDir); err != nil {
 				fmt.Printf("❌ Error generating project evaluation: %v\n", err)
 			} else {
 				fmt.Println("✅ Project evaluation completed successfully!")
@@ -324,26 +671,26 @@ func runInteractiveMode(root, outDir string, opts FlowchartOptions) {
 
 		// Ask if user wants to continue
 		fmt.Print("\n🔄 Generate more charts? (y/N): ")
-		var continueChoice string
-		fmt.Scanln(&continueChoice)
-
-		if continueChoice != "y" && continueChoice != "Y" && continueChoice != "yes" && continueChoice != "Yes" {
+		continueChoice, ok := scanAnswer()
+		if !ok || continueChoice != "y" && continueChoice != "Y" && continueChoice != "yes" && continueChoice != "Yes" {
 			fmt.Println("\n👋 Goodbye!")
 			return
 		}
 	}
 }
 
-// generateScannerReports runs only the project scanner functionality
-func generateScannerReports(root, outDir string) error {
-	fmt.Println("🔍 Scanning project for functions...")
-	structure, err := Existing_scanProject(root)
-	if err != nil {
-		return fmt.Errorf("project scanning failed: %w", err)
+// scanAnswer reads one answer from stdin. ok is false once input is exhausted (EOF),
+// so callers can stop prompting; an empty line is a valid "" answer.
+func scanAnswer() (string, bool) {
+	var answer string
+	if _, err := fmt.Scanln(&answer); errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", false
 	}
+	return answer, true
+}
 
-	fmt.Printf("✅ Found %d functions across %d files\n", len(structure.Functions), len(structure.Files))
-
+// generateScannerReports writes the project scanner reports from an existing scan
+func generateScannerReports(outDir string, structure *ProjectStructure) error {
 	fmt.Println("📝 Generating dynamic reports...")
 	return Existing_generateUpdatedReports(outDir, structure)
 }
@@ -395,34 +742,18 @@ func generateAIAdvisorDiagrams(outDir string) error {
 	return AIAd_WriteAllStructureDiagrams(outDir)
 }
 
-// generateExistingDiagrams runs the existing diagrams functionality
-func generateExistingDiagrams(root, outDir string) error {
+// generateExistingDiagrams runs the existing diagrams functionality on an existing scan of root
+func generateExistingDiagrams(root, outDir string, structure *ProjectStructure) error {
 	fmt.Println("📊 Generating existing diagrams...")
 
-	// First scan the project to get current functions
-	structure, err := Existing_scanProject(root)
-	if err != nil {
-		return fmt.Errorf("project scanning failed: %w", err)
-	}
-
-	fmt.Printf("✅ Found %d functions across %d files\n", len(structure.Functions), len(structure.Files))
-
 	// Generate existing diagrams based on discovered functions
 	if err := Existing_generateUpdatedReports(outDir, structure); err != nil {
 		return err
 	}
 
-	// Generate architecture and file tree diagrams
-	if err := Existing_WriteArchitectureDiagram(root, outDir); err != nil {
-		return fmt.Errorf("architecture diagram failed: %w", err)
-	}
-
-	// Generate both simplified and full function dependency diagrams
-	if err := Existing_WriteFunctionDependencyDiagram(root, outDir, 1); err != nil {
-		return fmt.Errorf("simplified function dependency diagram failed: %w", err)
-	}
-	if err := Existing_WriteFunctionDependencyDiagram(root, outDir, 2); err != nil {
-		return fmt.Errorf("full function dependency diagram failed: %w", err)
+	// Run the registered generators (architecture, SQL inventory, function dependencies, plug-ins)
+	if err := runGenerators(context.Background(), GeneratorConfig{Root: root, OutDir: outDir}, structure); err != nil {
+		return fmt.Errorf("generators failed: %w", err)
 	}
 
 	return nil
@@ -439,25 +770,7 @@ func viewAllCurrentCharts(root, outDir string) {
 	}
 
 	// Convert all .mmd.md files to HTML
-	htmlFilesCreated := 0
-	err := filepath.Walk(outDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if strings.HasSuffix(path, ".mmd.md") {
-			// Convert to HTML
-			if err := convertMermaidFileToHTML(path); err != nil {
-				fmt.Printf("⚠️  Warning: Could not convert %s to HTML: %v\n", filepath.Base(path), err)
-			} else {
-				htmlFile := strings.Replace(path, ".mmd.md", ".html", 1)
-				fmt.Printf("✅ Created: %s\n", filepath.Base(htmlFile))
-				htmlFilesCreated++
-			}
-		}
-		return nil
-	})
-
+	htmlFilesCreated, err := convertDirToHTML(outDir, false)
 	if err != nil {
 		fmt.Printf("❌ Error scanning for .mmd.md files: %v\n", err)
 		return
@@ -473,8 +786,7 @@ func viewAllCurrentCharts(root, outDir string) {
 
 	// Ask if user wants to open the HTML files
 	fmt.Print("\n🌐 Open HTML charts in browser? (y/N): ")
-	var openChoice string
-	fmt.Scanln(&openChoice)
+	openChoice, _ := scanAnswer()
 
 	if openChoice == "y" || openChoice == "Y" || openChoice == "yes" || openChoice == "Yes" {
 		fmt.Println("🌐 Opening HTML charts in browser...")
@@ -484,31 +796,139 @@ func viewAllCurrentCharts(root, outDir string) {
 	}
 }
 
-// convertMermaidFileToHTML converts a single .mmd.md file to HTML
-func convertMermaidFileToHTML(filePath string) error {
-	// Read the .mmd.md file
+// convertDirToHTML converts every .mmd.md under dir to HTML - and every other .md too when
+// reports is set - and returns how many HTML files it wrote
+func convertDirToHTML(dir string, reports bool) (int, error) {
+	created := 0
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		var convErr error
+		switch {
+		case strings.HasSuffix(path, ".mmd.md"):
+			convErr = convertMermaidFileToHTML(path)
+		case reports && strings.HasSuffix(path, ".md"):
+			convErr = convertMarkdownFileToHTML(path)
+		default:
+			return nil
+		}
+		if convErr != nil {
+			fmt.Printf("⚠️  Warning: Could not convert %s to HTML: %v\n", filepath.Base(path), convErr)
+			return nil
+		}
+		htmlFile := strings.TrimSuffix(strings.TrimSuffix(path, ".md"), ".mmd") + ".html"
+		fmt.Printf("✅ Created: %s\n", filepath.Base(htmlFile))
+		created++
+		return nil
+	})
+	return created, err
+}
+
+// runHTMLTask regenerates the HTML pages and index.html of an existing output directory
+// from its .mmd.md and .md files, without re-running any analysis (-task html)
+func runHTMLTask(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	fmt.Printf("🔄 Regenerating HTML from the .mmd.md and .md files in %s...\n", dir)
+	created, err := convertDirToHTML(dir, true)
+	if err != nil {
+		return fmt.Errorf("scanning %s: %w", dir, err)
+	}
+	if created == 0 {
+		return fmt.Errorf("no .mmd.md or .md files found in %s", dir)
+	}
+	if err := writeChartsIndex(dir); err != nil {
+		return fmt.Errorf("charts index: %w", err)
+	}
+	fmt.Printf("✅ Created %d HTML files and %s\n", created, filepath.Join(dir, "index.html"))
+	return nil
+}
+
+// convertMarkdownFileToHTML converts a Markdown report to HTML: the text is shown as written
+// and any ```mermaid blocks are rendered as diagrams
+func convertMarkdownFileToHTML(filePath string) error {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return fmt.Errorf("failed to read file: %w", err)
 	}
 
-	// Extract Mermaid content from the file
-	var mermaidContent strings.Builder
-	lines := strings.Split(string(content), "\n")
+	var body, text strings.Builder
+	flushText := func() {
+		if strings.TrimSpace(text.String()) != "" {
+			body.WriteString("    <pre>" + html.EscapeString(text.String()) + "</pre>\n")
+		}
+		text.Reset()
+	}
 	inMermaidBlock := false
-
-	for _, line := range lines {
-		if strings.TrimSpace(line) == "```mermaid" {
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case !inMermaidBlock && strings.TrimSpace(line) == "```mermaid":
+			flushText()
 			inMermaidBlock = true
-			continue
-		}
-		if strings.TrimSpace(line) == "```" && inMermaidBlock {
+			body.WriteString("    <div class=\"mermaid\">\n")
+		case inMermaidBlock && strings.TrimSpace(line) == "```":
 			inMermaidBlock = false
-			continue
+			body.WriteString("    </div>\n")
+		case inMermaidBlock:
+			body.WriteString(line + "\n")
+		default:
+			text.WriteString(line + "\n")
 		}
-		if inMermaidBlock {
-			mermaidContent.WriteString(line + "\n")
+	}
+	if inMermaidBlock {
+		body.WriteString("    </div>\n")
+	}
+	flushText()
+
+	title := filepath.Base(filePath)
+	htmlContent := fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <title>%s</title>
+    <script src="%s"></script>
+    <style>
+        body { font-family: Arial, sans-serif; margin: 0; padding: 10px; }
+        pre { white-space: pre-wrap; font-family: Consolas, monospace; }
+        .mermaid { text-align: center; }
+    </style>
+</head>
+<body>
+%s    <script>mermaid.initialize({startOnLoad:true});</script>
+</body>
+</html>
+`, html.EscapeString(title), mermaidScriptURL(), body.String())
+
+	htmlFile := strings.TrimSuffix(filePath, ".md") + ".html"
+	return os.WriteFile(htmlFile, []byte(htmlContent), 0644)
+}
+
+// convertMermaidFileToHTML converts a single .mmd.md file to HTML
+func convertMermaidFileToHTML(filePath string) error {
+	// Read the .mmd.md file
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	// One Mermaid div per diagram in the file (e.g. the pie and bar chart of the progress chart)
+	var mermaidContent strings.Builder
+	for i, block := range mermaidBlocks(string(content)) {
+		if i > 0 {
+			mermaidContent.WriteString("    </div>\n    <div class=\"mermaid\">\n")
 		}
+		mermaidContent.WriteString(block)
 	}
 
 	// Create HTML file with Mermaid.js
@@ -516,7 +936,7 @@ func convertMermaidFileToHTML(filePath string) error {
 <!DOCTYPE html>
 <html>
 <head>
-    <script src="https://cdn.jsdelivr.net/npm/mermaid/dist/mermaid.min.js"></script>
+    <script src="%s"></script>
     <style>
         body { font-family: Arial, sans-serif; margin: 0; padding: 10px; }
         .mermaid { text-align: center; }
@@ -528,7 +948,7 @@ func convertMermaidFileToHTML(filePath string) error {
     </div>
     <script>mermaid.initialize({startOnLoad:true});</script>
 </body>
-</html>`, mermaidContent.String())
+</html>`, mermaidScriptURL(), mermaidContent.String())
 
 	// Write HTML file
 	htmlFile := strings.Replace(filePath, ".mmd.md", ".html", 1)
@@ -597,7 +1017,8 @@ func openChartsInBrowser(outDir string) {
 // What: Orchestrates generation of function, package, and optional UML graphs.
 // Why: Single entry point to keep graphs up-to-date for large Go projects.
 // How: Verifies required tools, creates output dir, runs go-callvis and goda+dot; optionally goplantuml.
-func BTFlowcharts(projectRoot, outDir string, opts FlowchartOptions) error {
+// outAbs is the absolute output directory from resolveOutDir; every artifact is written under it.
+func BTFlowcharts(projectRoot, outAbs string, opts FlowchartOptions) error {
 	// Determine working dir: prefer provided root, else CWD; then resolve module root (go.mod)
 	wd := projectRoot
 	if wd == "" {
@@ -610,121 +1031,166 @@ func BTFlowcharts(projectRoot, outDir string, opts FlowchartOptions) error {
 	if mr, ok := findModuleRoot(wd); ok {
 		wd = mr
 	}
-	if err := ensureDir(filepath.Join(wd, outDir)); err != nil {
+	if err := validateCallvisOptions(opts); err != nil {
 		return err
 	}
-
-	// Ensure tools exist
-	if err := ensureTool("go-callvis"); err != nil {
-		return wrapInstallHint(err, "go install github.com/ofabry/go-callvis@latest")
-	}
-	if err := ensureTool("goda"); err != nil {
-		return wrapInstallHint(err, "go install github.com/loov/goda@latest")
-	}
-	if err := ensureTool("dot"); err != nil {
-		return wrapInstallHint(err, "winget install --id Graphviz.Graphviz -e")
+	if err := ensureDir(outAbs); err != nil {
+		return err
 	}
 
-	// Generate function call graph (graph.svg)
-	callvisArgs := []string{"-format", "svg", "-file", filepath.Join(outDir, "graph.svg")}
-	if opts.NoStdlib {
-		callvisArgs = append(callvisArgs, "-nostd")
-	}
-	if opts.Group != "" {
-		callvisArgs = append(callvisArgs, "-group", opts.Group)
-	}
-	if opts.Focus != "" {
-		callvisArgs = append(callvisArgs, "-focus", opts.Focus)
-	}
-	if opts.Ignore != "" {
-		callvisArgs = append(callvisArgs, "-ignore", opts.Ignore)
-	}
-	if opts.IncludeTests {
-		callvisArgs = append(callvisArgs, "-tests")
-	}
-	callvisArgs = append(callvisArgs, "./...")
-	if err := runInDir(wd, "go-callvis", callvisArgs...); err != nil {
-		fmt.Printf("⚠️  go-callvis failed (expected with multiple main packages): %v\n", err)
-		fmt.Println("   This is normal when running multiple chart files together.")
-		fmt.Println("   Other charts will still be generated successfully.")
+	// External tools are optional: a missing one skips its charts, only the pure-Go reports can fail the run.
+	// -docs-only never runs them.
+	haveCallvis, haveGoda, haveDot := false, false, false
+	if opts.DocsOnly {
+		fmt.Println("📚 Docs only: skipping go-callvis, goda, dot and goplantuml")
+	} else {
+		haveCallvis = warnMissingTool("go-callvis", "go install github.com/ofabry/go-callvis@latest")
+		haveGoda = warnMissingTool("goda", "go install github.com/loov/goda@latest")
+		haveDot = warnMissingTool("dot", "winget install --id Graphviz.Graphviz -e")
+		if !haveCallvis || !haveGoda || !haveDot {
+			if err := strictErr("external tools", errors.New("go-callvis, goda or dot is missing")); err != nil {
+				return err
+			}
+		}
 	}
 
-	// Extra 1: generate a package-grouped call graph (alternative perspective)
-	byPkg := append([]string{}, callvisArgs...)
-	for i := range byPkg {
-		if byPkg[i] == filepath.Join(outDir, "graph.svg") {
-			byPkg[i] = filepath.Join(outDir, "graph_by_pkg.svg")
+	if haveCallvis {
+		// Generate function call graph (graph.svg)
+		callvisArgs := []string{"-format", "svg", "-file", filepath.Join(outAbs, "graph.svg")}
+		if opts.NoStdlib {
+			callvisArgs = append(callvisArgs, "-nostd")
+		}
+		if opts.Group != "" {
+			callvisArgs = append(callvisArgs, "-group", opts.Group)
+		}
+		if opts.Focus != "" {
+			callvisArgs = append(callvisArgs, "-focus", opts.Focus)
+		}
+		if opts.Ignore != "" {
+			callvisArgs = append(callvisArgs, "-ignore", opts.Ignore)
+		}
+		if opts.IncludeTests {
+			callvisArgs = append(callvisArgs, "-tests")
+		}
+		callvisArgs = append(callvisArgs, "./...")
+		stopCallvis := profileStep("go-callvis")
+		if err := runInDir(wd, "go-callvis", callvisArgs...); err != nil {
+			fmt.Printf("⚠️  go-callvis failed (expected with multiple main packages): %v\n", err)
+			fmt.Println("   This is normal when running multiple chart files together.")
+			fmt.Println("   Other charts will still be generated successfully.")
+			if err := strictErr("go-callvis", err); err != nil {
+				return err
+			}
 		}
-	}
-	if idx := indexOf(byPkg, "-group"); idx >= 0 && idx+1 < len(byPkg) {
-		byPkg[idx+1] = "pkg"
-	} else {
-		byPkg = append([]string{"-group", "pkg"}, byPkg...)
-	}
-	if err := runInDir(wd, "go-callvis", byPkg...); err != nil {
-		fmt.Println("Note: pkg-grouped graph generation failed (continuing):", err)
-	}
 
-	// Extra 2: generate a full graph including stdlib to surface DB/sql edges
-	full := []string{"-format", "svg", "-file", filepath.Join(outDir, "graph_full.svg")}
-	// do not add -nostd here on purpose
-	if opts.Group != "" {
-		full = append(full, "-group", opts.Group)
-	}
-	if opts.Focus != "" {
-		full = append(full, "-focus", opts.Focus)
-	}
-	if opts.Ignore != "" {
-		full = append(full, "-ignore", opts.Ignore)
-	}
-	if opts.IncludeTests {
-		full = append(full, "-tests")
-	}
-	full = append(full, "./...")
-	if err := runInDir(wd, "go-callvis", full...); err != nil {
-		fmt.Println("Note: full stdlib-inclusive graph generation failed (continuing):", err)
-	}
+		// Extra 1: generate a package-grouped call graph (alternative perspective)
+		byPkg := append([]string{}, callvisArgs...)
+		for i := range byPkg {
+			if byPkg[i] == filepath.Join(outAbs, "graph.svg") {
+				byPkg[i] = filepath.Join(outAbs, "graph_by_pkg.svg")
+			}
+		}
+		if idx := indexOf(byPkg, "-group"); idx >= 0 && idx+1 < len(byPkg) {
+			byPkg[idx+1] = "pkg"
+		} else {
+			byPkg = append([]string{"-group", "pkg"}, byPkg...)
+		}
+		if err := runInDir(wd, "go-callvis", byPkg...); err != nil {
+			fmt.Println("Note: pkg-grouped graph generation failed (continuing):", err)
+			if err := strictErr("pkg-grouped graph", err); err != nil {
+				return err
+			}
+		}
 
-	// Extra 3: if a migrations package exists, generate a focused graph to surface those edges
-	if dirExists(filepath.Join(wd, "migrations")) {
-		focusVal := "migrations"
-		if mod := readModulePath(filepath.Join(wd, "go.mod")); mod != "" {
-			focusVal = mod + "/migrations"
+		// Extra 2: generate a full graph including stdlib to surface DB/sql edges
+		full := []string{"-format", "svg", "-file", filepath.Join(outAbs, "graph_full.svg")}
+		// do not add -nostd here on purpose
+		if opts.Group != "" {
+			full = append(full, "-group", opts.Group)
+		}
+		if opts.Focus != "" {
+			full = append(full, "-focus", opts.Focus)
+		}
+		if opts.Ignore != "" {
+			full = append(full, "-ignore", opts.Ignore)
 		}
-		mig := []string{"-format", "svg", "-file", filepath.Join(outDir, "graph_migrations.svg"), "-group", "pkg,type"}
 		if opts.IncludeTests {
-			mig = append(mig, "-tests")
+			full = append(full, "-tests")
 		}
-		mig = append(mig, "-focus", focusVal, "./...")
-		if err := runInDir(wd, "go-callvis", mig...); err != nil {
-			fmt.Println("Note: migrations-focused graph generation failed (continuing):", err)
+		full = append(full, "./...")
+		if err := runInDir(wd, "go-callvis", full...); err != nil {
+			fmt.Println("Note: full stdlib-inclusive graph generation failed (continuing):", err)
+			if err := strictErr("full graph", err); err != nil {
+				return err
+			}
 		}
+
+		// Extra 3: if a migrations package exists, generate a focused graph to surface those edges
+		if dirExists(filepath.Join(wd, "migrations")) {
+			focusVal := "migrations"
+			if mod := readModulePath(filepath.Join(wd, "go.mod")); mod != "" {
+				focusVal = mod + "/migrations"
+			}
+			mig := []string{"-format", "svg", "-file", filepath.Join(outAbs, "graph_migrations.svg"), "-group", "pkg,type"}
+			if opts.IncludeTests {
+				mig = append(mig, "-tests")
+			}
+			mig = append(mig, "-focus", focusVal, "./...")
+			if err := runInDir(wd, "go-callvis", mig...); err != nil {
+				fmt.Println("Note: migrations-focused graph generation failed (continuing):", err)
+				if err := strictErr("migrations graph", err); err != nil {
+					return err
+				}
+			}
+		}
+		stopCallvis()
 	}
 
 	// Generate package dependency graph (pkg-deps.dot -> .svg)
-	dotPath := filepath.Join(outDir, "pkg-deps.dot")
-	svgPath := filepath.Join(outDir, "pkg-deps.svg")
+	dotPath := filepath.Join(outAbs, "pkg-deps.dot")
+	svgPath := filepath.Join(outAbs, "pkg-deps.svg")
 	// Note: We capture 'goda graph' output to a .dot file explicitly.
 	// If you only need the file, the prior invocation can be skipped.
 	// Pipe is not as portable; call `goda graph` to file via cmd redirection
-	if err := writeFileFromCmd(wd, []string{"goda", "graph", "./..."}, dotPath); err != nil {
-		return fmt.Errorf("write dot: %w", err)
-	}
-	if err := runInDir(wd, "dot", "-Tsvg", dotPath, "-o", svgPath); err != nil {
-		return fmt.Errorf("dot convert: %w", err)
+	stopGoda := profileStep("goda + dot")
+	haveSVG := false
+	if haveGoda {
+		if err := writeFileFromCmd(wd, []string{"goda", "graph", "./..."}, dotPath); err != nil {
+			fmt.Printf("⚠️  goda failed (continuing without the package graph): %v\n", err)
+			if err := strictErr("goda", err); err != nil {
+				return err
+			}
+		} else if !haveDot {
+			fmt.Printf("📄 Kept %s - render it later with: dot -Tsvg %s -o %s\n", dotPath, dotPath, svgPath)
+		} else if err := runInDir(wd, "dot", "-Tsvg", dotPath, "-o", svgPath); err != nil {
+			fmt.Printf("⚠️  dot failed (continuing): %v\n", err)
+			fmt.Printf("📄 Kept %s - render it later with: dot -Tsvg %s -o %s\n", dotPath, dotPath, svgPath)
+			if err := strictErr("dot", err); err != nil {
+				return err
+			}
+		} else {
+			haveSVG = true
+		}
 	}
+	stopGoda()
 
 	// Optionally generate a PlantUML class diagram of structs/interfaces if available.
-	if opts.GenerateUML {
+	if opts.GenerateUML && !opts.DocsOnly {
+		stopUML := profileStep("goplantuml + PlantUML")
 		if err := ensureTool("goplantuml"); err == nil {
-			umlPath := filepath.Join(outDir, "types.puml")
+			umlPath := filepath.Join(outAbs, "types.puml")
 			if err := writeFileFromCmd(wd, []string{"goplantuml", "-recursive", "."}, umlPath); err != nil {
-				return fmt.Errorf("goplantuml: %w", err)
-			}
-			// Render types.puml to SVG if PlantUML (or plantuml.jar + java) is available.
-			if cmd, args, ok := findPlantUMLRenderer(); ok {
-				if err := runInDir(filepath.Join(wd, outDir), cmd, append(args, "types.puml")...); err != nil {
+				fmt.Println("⚠️  goplantuml failed (continuing without the UML diagram):", err)
+				if err := strictErr("goplantuml", err); err != nil {
+					return err
+				}
+			} else if cmd, args, ok := findPlantUMLRenderer(); ok {
+				// Render types.puml to SVG if PlantUML (or plantuml.jar + java) is available.
+				if err := runInDir(outAbs, cmd, append(args, "types.puml")...); err != nil {
 					fmt.Println("Note: PlantUML render failed (continuing):", err)
+					if err := strictErr("PlantUML render", err); err != nil {
+						return err
+					}
 				}
 			} else {
 				fmt.Println("Note: types.puml generated; PlantUML not found on PATH. Install PlantUML or set PLANTUML_JAR to render SVG.")
@@ -733,45 +1199,132 @@ func BTFlowcharts(projectRoot, outDir string, opts FlowchartOptions) error {
 		} else {
 			fmt.Println("Note: skipping UML generation (goplantuml not found)")
 		}
+		stopUML()
 	}
 
 	// Step 1: Scan project for functions and generate dynamic reports
 	fmt.Println("🔍 Scanning project for functions and files...")
+	stopScan := profileStep("scan")
 	structure, err := Existing_scanProject(wd)
+	stopScan()
 	if err != nil {
 		fmt.Printf("⚠️  Project scan failed: %v (continuing with static charts)\n", err)
+		if err := strictErr("project scan", err); err != nil {
+			return err
+		}
 	} else {
+		// -focus and -ignore narrow every report below, not only the go-callvis graphs
+		if focusRegex != nil || ignoreRegex != nil {
+			structure.Functions = Existing_focusFunctions(structure.Functions)
+			fmt.Printf("🎯 -focus/-ignore kept %d functions\n", len(structure.Functions))
+		}
 		// Generate dynamic reports based on discovered functions
-		if err := Existing_generateUpdatedReports(outDir, structure); err != nil {
+		if err := Existing_generateUpdatedReports(outAbs, structure); err != nil {
 			fmt.Printf("⚠️  Dynamic reports failed: %v (continuing with static charts)\n", err)
+			if err := strictErr("dynamic reports", err); err != nil {
+				return err
+			}
 		} else {
 			fmt.Printf("✅ Generated dynamic reports: %d functions across %d files\n", len(structure.Functions), len(structure.Files))
 		}
+		// Save the structure so several runs can be combined with -task merge
+		if err := Existing_WriteStructureJSON(outAbs, wd, structure); err != nil {
+			fmt.Printf("⚠️  Structure JSON failed: %v (continuing)\n", err)
+			if err := strictErr("structure JSON", err); err != nil {
+				return err
+			}
+		}
+		if opts.SARIF != "" {
+			if err := Existing_WriteSARIF(opts.SARIF, wd, structure); err != nil {
+				fmt.Printf("⚠️  SARIF failed: %v (continuing)\n", err)
+				if err := strictErr("SARIF", err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Run the registered generators: architecture, SQL inventory, function dependencies and any plug-ins.
+	// Each failure is reported by runGenerators; the remaining charts still get generated.
+	if err := runGenerators(context.Background(), GeneratorConfig{Root: wd, OutDir: outAbs, Options: opts}, structure); err != nil {
+		if err := strictErr("generators", err); err != nil {
+			return err
+		}
 	}
 
 	// Step 2: Generate static educational charts
-	// Bonus: emit a lightweight Mermaid architecture diagram for higher-level relationships.
-	_ = Existing_WriteArchitectureDiagram(wd, outDir)
 	// Emit a Mermaid file/package tree for quick project overview.
-	//_ = Existing_WriteFileTreeDiagram(wd, outDir)
+	// (now the "file-tree" registered generator; directories come from -tree-dirs)
 	// Generate current project OG diagrams based on discovered functions
 	// if structure != nil {
-	// 	_ = Theory_WriteProjectOGDiagrams(outDir, structure)
+	// 	_ = Theory_WriteProjectOGDiagrams(outAbs, structure)
 	// }
 	// Emit function flow analysis diagrams for learning and development guidance.
-	_ = AIAd_WriteFunctionFlowAnalysis(outDir)
+	stopFlow := profileStep("AI advisor function flow")
+	if err := AIAd_WriteFunctionFlowAnalysis(outAbs, structure); err != nil {
+		fmt.Printf("⚠️  Function flow analysis failed: %v (continuing)\n", err)
+		if err := strictErr("function flow analysis", err); err != nil {
+			return err
+		}
+	}
+	stopFlow()
 	// Optionally generate ERD via SchemaSpy if environment is configured and user agrees.
-	//_ = GenerateSchemaSpyERD(wd, outDir)
+	//_ = GenerateSchemaSpyERD(wd, outAbs)
 	// SchemaSpy ERD generation moved to individual options to avoid duplicate prompts
 
-	fmt.Printf("Generated:\n- %s\n- %s\n", filepath.Join(outDir, "types.svg"), svgPath)
-	// return nil
+	fmt.Println("Generated:")
+	for _, chart := range []string{filepath.Join(outAbs, "types.svg"), filepath.Join(outAbs, "graph.svg"), svgPath, dotPath} {
+		if fileExists(chart) && (chart != dotPath || !haveSVG) {
+			fmt.Printf("- %s\n", chart)
+		}
+	}
 
-	// Open the generated files - this is the new way to open the files
-	fmt.Printf("Generated:\n- %s\n- %s\n", filepath.Join(outDir, "graph.svg"), svgPath)
+	// Anonymize last so every diagram above was drawn from the real names
+	if opts.Anonymize {
+		stopAnon := profileStep("anonymize")
+		var extra []string
+		if opts.SARIF != "" {
+			extra = append(extra, opts.SARIF)
+		}
+		if err := Anonymize_RewriteOutputs(outAbs, structure, extra...); err != nil {
+			return fmt.Errorf("anonymize: %w", err)
+		}
+		stopAnon()
+	}
 
-	// Always open all charts at the end (required)
-	openAllCharts(outDir)
+	// Plain .mmd copies for mmdc and editor plugins, after anonymizing so they match the .mmd.md files
+	if rawMermaid {
+		if n, err := writeRawMermaid(outAbs); err != nil {
+			fmt.Printf("⚠️  Raw Mermaid files failed: %v (continuing)\n", err)
+			if err := strictErr("raw mermaid", err); err != nil {
+				return err
+			}
+		} else {
+			fmt.Printf("✅ Wrote %d raw .mmd files\n", n)
+		}
+	}
+
+	// Print the time breakdown before the browser takes over
+	printProfileSummary()
+
+	// Always open the charts at the end (required); a multi-root run opens one combined index instead
+	if opts.SkipOpen {
+		createMermaidHTML(outAbs)
+		if err := writeChartsIndex(outAbs); err != nil {
+			fmt.Printf("⚠️  Charts index failed: %v\n", err)
+			if err := strictErr("charts index", err); err != nil {
+				return err
+			}
+		}
+		if opts.DocsOnly {
+			if err := writeDiagramsMarkdown(outAbs); err != nil {
+				return fmt.Errorf("DIAGRAMS.md: %w", err)
+			}
+			fmt.Printf("📚 Docs written to %s (start with DIAGRAMS.md or index.html)\n", outAbs)
+		}
+	} else {
+		openAllCharts(outAbs)
+	}
 	return nil
 }
 
@@ -783,6 +1336,26 @@ func ensureTool(name string) error {
 	return nil
 }
 
+// strictMode turns the "(continuing)" warnings of BTFlowcharts into errors that abort the run (set from -strict)
+var strictMode bool
+
+// strictErr returns err labelled with step under -strict and nil otherwise; callers print their warning first
+func strictErr(step string, err error) error {
+	if !strictMode {
+		return nil
+	}
+	return fmt.Errorf("%s failed (-strict): %w", step, err)
+}
+
+// warnMissingTool reports whether a tool is on PATH, printing an install hint when it is not
+func warnMissingTool(name, hint string) bool {
+	if err := ensureTool(name); err != nil {
+		fmt.Printf("⚠️  %v - skipping its charts\n   Install hint: %s\n", err, hint)
+		return false
+	}
+	return true
+}
+
 // wrapInstallHint adds a short install hint to an error (keeps original error wrapped).
 func wrapInstallHint(err error, hint string) error {
 	return fmt.Errorf("%w\nInstall hint: %s", err, hint)
@@ -877,21 +1450,20 @@ func indexOf(slice []string, target string) int {
 
 // readModulePath returns the module path from go.mod if available.
 func readModulePath(goModPath string) string {
-	f, err := os.Open(goModPath)
+	data, err := os.ReadFile(goModPath)
 	if err != nil {
 		return ""
 	}
-	defer f.Close()
-	buf := make([]byte, 256)
-	n, _ := f.Read(buf)
-	if n <= 0 {
-		return ""
-	}
-	text := string(buf[:n])
+	// Editors on Windows may save go.mod with a UTF-8 BOM and CRLF line endings
+	text := strings.TrimPrefix(string(data), "\uFEFF")
 	for _, line := range strings.Split(text, "\n") {
-		line = strings.TrimSpace(line)
-		if strings.HasPrefix(line, "module ") {
-			return strings.TrimSpace(strings.TrimPrefix(line, "module "))
+		line, _, _ = strings.Cut(line, "//")
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "module" {
+			if unquoted, err := strconv.Unquote(fields[1]); err == nil {
+				return unquoted
+			}
+			return fields[1]
 		}
 	}
 	return ""
@@ -933,8 +1505,21 @@ func findModuleRoot(startDir string) (string, bool) {
 
 // writeProjectBuildingGuide has been moved to StructureDiagrams.go
 
-// openAllCharts opens all generated charts (required for BTFlowcharts)
+// openAllCharts opens the generated charts (required for BTFlowcharts): only index.html
+// by default, every chart with -open-all (one tab at a time, openAllDelay apart)
 func openAllCharts(outDir string) {
+	// Create the HTML versions of Mermaid files first so the index can link them
+	htmlFiles := createMermaidHTML(outDir)
+
+	indexPath := filepath.Join(outDir, "index.html")
+	if err := writeChartsIndex(outDir); err != nil {
+		fmt.Printf("⚠️  Charts index failed: %v (falling back to opening every chart)\n", err)
+	} else if !openAll {
+		exec.Command("cmd", "/c", "start", indexPath).Start()
+		fmt.Printf("🌐 Opened %s (use -open-all to open every chart)\n", indexPath)
+		return
+	}
+
 	// Open ERD using the new SchemaERD functionality
 	OpenERDInBrowser(outDir)
 
@@ -950,25 +1535,97 @@ func openAllCharts(outDir string) {
 
 	for _, svgFile := range svgFiles {
 		if fileExists(svgFile) {
+			time.Sleep(openAllDelay)
 			exec.Command("cmd", "/c", "start", svgFile).Start()
 			fmt.Printf("Opened %s\n", filepath.Base(svgFile))
 		}
 	}
 
-	// Create and open HTML versions of Mermaid files
-	createMermaidHTML(outDir)
+	for _, htmlFile := range htmlFiles {
+		time.Sleep(openAllDelay)
+		exec.Command("cmd", "/c", "start", htmlFile).Start()
+		fmt.Printf("Opened %s\n", filepath.Base(htmlFile))
+	}
+}
+
+// openAll opens every chart instead of only index.html (set from -open-all)
+var openAll bool
+
+// openAllDelay spaces out the tabs opened with -open-all so the browser is not flooded
+const openAllDelay = 300 * time.Millisecond
+
+// writeChartsIndex writes <outDir>/index.html linking every chart and report in outDir
+func writeChartsIndex(outDir string) error {
+	entries, err := os.ReadDir(outDir)
+	if err != nil {
+		return err
+	}
+	sections := []struct {
+		title string
+		ext   string
+	}{
+		{"🌐 HTML Charts", ".html"},
+		{"🖼️ SVG Graphs", ".svg"},
+		{"📝 Markdown Reports", ".md"},
+	}
+
+	var b strings.Builder
+	b.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>BTFlowcharts Index</title>
+    <style>
+        body { font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif; margin: 20px; background: #f8f9fa; }
+        h1 { color: #2c3e50; border-bottom: 3px solid #3498db; padding-bottom: 10px; }
+        li { margin: 4px 0; }
+    </style>
+</head>
+<body>
+    <h1>📊 BTFlowcharts Index</h1>
+`)
+	for _, section := range sections {
+		var links []string
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || name == "index.html" || filepath.Ext(name) != section.ext {
+				continue
+			}
+			links = append(links, name)
+		}
+		// Sub-folders with their own index
+		for _, sub := range []string{"BTspyERD/index.html", "per_file/index.md"} {
+			if filepath.Ext(sub) == section.ext && fileExists(filepath.Join(outDir, filepath.FromSlash(sub))) {
+				links = append(links, sub)
+			}
+		}
+		if len(links) == 0 {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("    <h2>%s (%d)</h2>\n    <ul>\n", section.title, len(links)))
+		for _, link := range links {
+			b.WriteString(fmt.Sprintf("        <li><a href=\"%s\" target=\"_blank\">%s</a></li>\n", html.EscapeString(link), html.EscapeString(link)))
+		}
+		b.WriteString("    </ul>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+
+	return os.WriteFile(filepath.Join(outDir, "index.html"), []byte(b.String()), 0644)
 }
 
-func createMermaidHTML(outDir string) {
+// createMermaidHTML writes an HTML page for each known Mermaid chart and returns their paths
+func createMermaidHTML(outDir string) []string {
 	mermaidFiles := []string{
 		filepath.Join(outDir, "Existing_architecture.mmd.md"),
 		filepath.Join(outDir, "Existing_function_dependencies_simplified.mmd.md"),
 		filepath.Join(outDir, "Existing_function_dependencies_full.mmd.md"),
+		filepath.Join(outDir, "Existing_function_dependencies_handlers.mmd.md"),
 		filepath.Join(outDir, "Existing_application_brain.mmd.md"),
 		filepath.Join(outDir, "Existing_store_connections.mmd.md"),
 		filepath.Join(outDir, "AIAd_development_sequence.mmd.md"),
 		filepath.Join(outDir, "AIAd_execution_flow.mmd.md"),
 		filepath.Join(outDir, "AIAd_function_dependencies.mmd.md"),
+		filepath.Join(outDir, "AIAd_user_journey.mmd.md"),
 		filepath.Join(outDir, "Existing_dynamic_development_sequence.mmd.md"),
 		filepath.Join(outDir, "AIAdCreate_Exe_function_creation_order.mmd.md"),
 		filepath.Join(outDir, "AIAdCreate_Exe_function_execution_order.mmd.md"),
@@ -980,6 +1637,7 @@ func createMermaidHTML(outDir string) {
 		filepath.Join(outDir, "ProjectEvaluator_comprehensive_assessment.mmd.md"),
 	}
 
+	var created []string
 	for _, file := range mermaidFiles {
 		// Read the .mmd file content
 		content, err := os.ReadFile(file)
@@ -1014,7 +1672,7 @@ func createMermaidHTML(outDir string) {
     <meta charset="utf-8">
     <meta name="viewport" content="width=device-width, initial-scale=1.0">
     <title>Function Dependencies - High Resolution</title>
-    <script src="https://cdn.jsdelivr.net/npm/mermaid/dist/mermaid.min.js"></script>
+    <script src="%s"></script>
     <style>
         body { 
             font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif; 
@@ -1099,14 +1757,16 @@ func createMermaidHTML(outDir string) {
         });
     </script>
 </body>
-</html>`, mermaidContent.String())
+</html>`, mermaidScriptURL(), mermaidContent.String())
 
-		// Write HTML file
+		// Write HTML file (openAllCharts decides what to open)
 		htmlFile := strings.Replace(file, ".mmd.md", ".html", 1)
-		os.WriteFile(htmlFile, []byte(htmlContent), 0644)
-
-		// Open HTML file in browser
-		exec.Command("cmd", "/c", "start", htmlFile).Start()
-		fmt.Printf("Created and opened %s\n", filepath.Base(htmlFile))
+		if err := os.WriteFile(htmlFile, []byte(htmlContent), 0644); err != nil {
+			fmt.Printf("⚠️  Could not write %s: %v\n", filepath.Base(htmlFile), err)
+			continue
+		}
+		fmt.Printf("Created %s\n", filepath.Base(htmlFile))
+		created = append(created, htmlFile)
 	}
+	return created
 }
@@ -0,0 +1,122 @@
+//go:build flowcharts
+
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestReadModulePath(t *testing.T) {
+	tests := []struct{ name, content, want string }{
+		{"plain", "module example.com/plain\n\ngo 1.22\n", "example.com/plain"},
+		{"BOM and CRLF", "\uFEFF// Saved by a Windows editor\r\nmodule example.com/bom-crlf // trailing comment\r\n\r\ngo 1.22\r\n", "example.com/bom-crlf"},
+		{"no module line", "go 1.22\n", ""},
+	}
+	for _, tt := range tests {
+		goMod := filepath.Join(t.TempDir(), "go.mod")
+		if err := os.WriteFile(goMod, []byte(tt.content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if got := readModulePath(goMod); got != tt.want {
+			t.Errorf("%s: readModulePath = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+	if got := readModulePath(filepath.Join(t.TempDir(), "missing.mod")); got != "" {
+		t.Errorf("missing go.mod: readModulePath = %q", got)
+	}
+}
+
+// A full BTFlowcharts run - index, raw Mermaid, SARIF and the anonymize mapping included - writes
+// every artifact under outAbs: nothing new in the project outside it, nothing in the working directory
+func TestBTFlowchartsWritesOnlyUnderOutDir(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "sample")
+	if err := SelfTest_writeSample(root); err != nil {
+		t.Fatal(err)
+	}
+	cwd := t.TempDir()
+	t.Chdir(cwd)
+	rawMermaid = true
+	defer func() { rawMermaid = false }()
+
+	before := listEntries(root, true)
+	outAbs := resolveOutDir(root, defaultOutDir)
+	opts := FlowchartOptions{
+		DocsOnly:  true, // no go-callvis, goda, dot or goplantuml
+		SkipOpen:  true,
+		Anonymize: true,
+		SARIF:     filepath.Join(outAbs, "findings.sarif"),
+	}
+	if err := BTFlowcharts(root, outAbs, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"index.html", "Existing_architecture.mmd", "findings.sarif", "mapping.json", "DIAGRAMS.md"} {
+		if _, err := os.Stat(filepath.Join(outAbs, name)); err != nil {
+			t.Errorf("expected output %s: %v", name, err)
+		}
+	}
+	outRel := Existing_relSlash(root, outAbs)
+	for path := range listEntries(root, true) {
+		if !before[path] && path != outRel && !strings.HasPrefix(path, outRel+"/") {
+			t.Errorf("artifact %s written into the project instead of %s", path, outRel)
+		}
+	}
+	for name := range listEntries(cwd, false) {
+		t.Errorf("artifact %s written into the working directory", name)
+	}
+}
+
+// -focus narrows the scanner-based reports of a BTFlowcharts run, not only go-callvis
+func TestBTFlowchartsFocusFiltersReports(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "sample")
+	if err := SelfTest_writeSample(root); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(t.TempDir())
+	focusRegex = regexp.MustCompile("internal/store")
+	defer func() { focusRegex = nil }()
+
+	outAbs := resolveOutDir(root, defaultOutDir)
+	if err := BTFlowcharts(root, outAbs, FlowchartOptions{DocsOnly: true, SkipOpen: true}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(outAbs, "Existing_function_inventory.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "GetUserByID") || strings.Contains(string(data), "HandleGetUser") {
+		t.Errorf("inventory should list the store functions only:\n%s", data)
+	}
+}
+
+// listEntries returns the paths under dir (recursive) or its direct entries
+func listEntries(dir string, recursive bool) map[string]bool {
+	entries := make(map[string]bool)
+	if !recursive {
+		list, _ := os.ReadDir(dir)
+		for _, entry := range list {
+			entries[entry.Name()] = true
+		}
+		return entries
+	}
+	_ = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil {
+			entries[Existing_relSlash(dir, path)] = true
+		}
+		return nil
+	})
+	return entries
+}
@@ -0,0 +1,205 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+CLASS MODEL COMPARE - NAME-BASED CONFORMANCE TO THE TEACHING MODEL
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The ClassModelBuilder guides describe a canonical project: which
+             files to create (main.go, internal/app/app.go, routes.go,
+             workout_store.go, ...) and which functions go in them. This file
+             checks a real project against that list by name only - a file is
+             found when its path matches, a function when any Go file declares
+             it - and writes a ✅/❌ checklist with a completeness percentage.
+             Unlike the phase heuristics, nothing is guessed from content.
+
+TO USE THIS FILE:
+1. Run with -task compare-to-model (-root selects the project)
+2. Open <out>/ClassModel_conformance.md
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// classModelFiles is the file creation sequence of ClassModelBuilder_WriteFileCreationSequence
+var classModelFiles = []string{
+	"main.go",
+	"go.mod",
+	".gitignore",
+	"internal/app/app.go",
+	"internal/routes/routes.go",
+	"internal/api/workout_handler.go",
+	"internal/api/user_handler.go",
+	"internal/api/token_handler.go",
+	"docker-compose.yml",
+	"internal/database/database.go",
+	"internal/database/migrate.go",
+	"internal/store/workout_store.go",
+	"internal/store/user_store.go",
+	"internal/store/token_store.go",
+	"internal/middleware/auth.go",
+	"internal/middleware/cors.go",
+	"internal/middleware/ownership.go",
+	"internal/api/workout_handler_test.go",
+	"internal/store/workout_store_test.go",
+	"main_test.go",
+}
+
+// classModelFunction is one function of ClassModelBuilder_WriteFunctionImplementationGuide
+type classModelFunction struct {
+	Name string
+	File string // where the guide puts it
+}
+
+// classModelFunctions is the function implementation order of the teaching guide
+var classModelFunctions = []classModelFunction{
+	{"main", "main.go"},
+	{"NewApplication", "internal/app/app.go"},
+	{"HealthCheck", "internal/app/app.go"},
+	{"SetupRoutes", "internal/routes/routes.go"},
+	{"NewWorkoutHandler", "internal/api/workout_handler.go"},
+	{"HandleGetWorkoutByID", "internal/api/workout_handler.go"},
+	{"HandleCreateWorkout", "internal/api/workout_handler.go"},
+	{"HandleUpdateWorkout", "internal/api/workout_handler.go"},
+	{"HandleDeleteWorkout", "internal/api/workout_handler.go"},
+	{"OpenDatabase", "internal/database/database.go"},
+	{"Migrate", "internal/database/migrate.go"},
+	{"NewWorkoutStore", "internal/store/workout_store.go"},
+	{"CreateWorkout", "internal/store/workout_store.go"},
+	{"GetWorkoutByID", "internal/store/workout_store.go"},
+	{"UpdateWorkout", "internal/store/workout_store.go"},
+	{"DeleteWorkout", "internal/store/workout_store.go"},
+	{"NewUserStore", "internal/store/user_store.go"},
+	{"CreateUser", "internal/store/user_store.go"},
+	{"GetUserByEmail", "internal/store/user_store.go"},
+	{"NewTokenStore", "internal/store/token_store.go"},
+	{"CreateToken", "internal/store/token_store.go"},
+	{"ValidateToken", "internal/store/token_store.go"},
+	{"AuthMiddleware", "internal/middleware/auth.go"},
+	{"CORSMiddleware", "internal/middleware/cors.go"},
+	{"ValidateOwnership", "internal/middleware/ownership.go"},
+}
+
+// ClassModelBuilder_CompareToModel checks root for every canonical file and function and writes a checklist
+func ClassModelBuilder_CompareToModel(root, outDir string) error {
+	fmt.Println("📐 Comparing project to the Class Model Builder canonical files...")
+	files, functions, err := ClassModelBuilder_scanNames(root)
+	if err != nil {
+		return fmt.Errorf("scan %s: %w", root, err)
+	}
+
+	var b strings.Builder
+	b.WriteString("# Class Model Conformance - Auto-Generated\n\n")
+	b.WriteString("Checks the project against the canonical files and functions of the Class Model Builder guides, by name only.\n\n")
+
+	found := 0
+	b.WriteString(fmt.Sprintf("## 📁 Files (%d)\n\n", len(classModelFiles)))
+	b.WriteString("| | Expected File | Found At |\n")
+	b.WriteString("|---|---------------|----------|\n")
+	for _, want := range classModelFiles {
+		status, at := "❌", "-"
+		if path := ClassModelBuilder_matchFile(files, want); path != "" {
+			status, at = "✅", "`"+path+"`"
+			found++
+		}
+		b.WriteString(fmt.Sprintf("| %s | `%s` | %s |\n", status, want, at))
+	}
+
+	b.WriteString(fmt.Sprintf("\n## ⚙️ Functions (%d)\n\n", len(classModelFunctions)))
+	b.WriteString("| | Expected Function | Model File | Found At |\n")
+	b.WriteString("|---|-------------------|------------|----------|\n")
+	for _, want := range classModelFunctions {
+		status, at := "❌", "-"
+		if path, ok := functions[want.Name]; ok {
+			status, at = "✅", "`"+path+"`"
+			found++
+		}
+		b.WriteString(fmt.Sprintf("| %s | `%s()` | `%s` | %s |\n", status, want.Name, want.File, at))
+	}
+
+	total := len(classModelFiles) + len(classModelFunctions)
+	percent := found * 100 / total
+	b.WriteString("\n## 📊 Completeness\n\n")
+	b.WriteString(fmt.Sprintf("**%d / %d artifacts present (%d%%)**\n", found, total, percent))
+
+	if err := ensureDir(outDir); err != nil {
+		return err
+	}
+	path := filepath.Join(outDir, "ClassModel_conformance.md")
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("✅ Model conformance: %d/%d artifacts (%d%%) - %s\n", found, total, percent, path)
+	return nil
+}
+
+// ClassModelBuilder_scanNames lists every file under root (relative, forward slashes) and
+// maps each declared function or method name to the first file declaring it
+func ClassModelBuilder_scanNames(root string) ([]string, map[string]string, error) {
+	var files []string
+	functions := make(map[string]string)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel := Existing_relSlash(root, path)
+		files = append(files, rel)
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil // a broken file only hides its own functions
+		}
+		for _, decl := range node.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok {
+				if _, seen := functions[fn.Name.Name]; !seen {
+					functions[fn.Name.Name] = rel
+				}
+			}
+		}
+		return nil
+	})
+	return files, functions, err
+}
+
+// ClassModelBuilder_matchFile returns the project file whose path is want or ends in /want
+func ClassModelBuilder_matchFile(files []string, want string) string {
+	for _, file := range files {
+		if file == want {
+			return file
+		}
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file, "/"+want) {
+			return file
+		}
+	}
+	return ""
+}
@@ -0,0 +1,224 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+COMPARE RUNS - FINAL SCORE AND STRUCTURE OVER GIT HISTORY (-compare-runs)
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: A capstone is graded on where it ended up, but the story is in
+             how it got there. -compare-runs N takes the last N commits that
+             touched the project (`git log`), checks each one out into a
+             temporary worktree (the -ref helper), runs the ProjectEvaluator
+             and scans the code there, and removes the worktree again. The
+             result is compare_runs.mmd.md: the final score per commit as a
+             Mermaid line chart, plus a table with the sub-scores and the
+             function/file counts and how much each changed since the commit
+             before. Every commit's result is cached in
+             compare-runs-cache/<sha>.json, so a re-run only evaluates the
+             commits that are new.
+
+TO USE THIS FILE:
+1. Run with -compare-runs 10 (the project must be inside a git repository)
+2. Open <out>/compare_runs.html or compare_runs.mmd.md
+
+NOTES:
+- A commit is only ever evaluated once; delete compare-runs-cache/ after
+  upgrading this tool so old commits are scored by the new rules
+- Commits whose checkout cannot be evaluated are skipped with a warning
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// compareRunsCacheDir holds one cached RunScore per commit, under the output directory
+const compareRunsCacheDir = "compare-runs-cache"
+
+// RunScore is the evaluation of one commit
+type RunScore struct {
+	Commit         string `json:"commit"` // full SHA, the cache key
+	Short          string `json:"short"`
+	Date           string `json:"date"`
+	Subject        string `json:"subject"`
+	FinalScore     int    `json:"final_score"`
+	Rating         string `json:"rating"`
+	StructureScore int    `json:"structure_score"`
+	QualityScore   int    `json:"quality_score"`
+	Functions      int    `json:"functions"`
+	Files          int    `json:"files"`
+	Packages       int    `json:"packages"`
+}
+
+// runCompareRuns evaluates the last n commits of projectRoot and writes compare_runs.mmd.md
+// (and its HTML page) to outAbs
+func runCompareRuns(projectRoot, outAbs string, n int) error {
+	// The project may live in a subdirectory of the repository
+	prefix, err := gitCommand(projectRoot, "rev-parse", "--show-prefix").Output()
+	if err != nil {
+		return fmt.Errorf("%s is not inside a git repository: %w", projectRoot, err)
+	}
+	runs, err := compareRunsCommits(projectRoot, n)
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 {
+		return fmt.Errorf("no commits touch %s", projectRoot)
+	}
+
+	cacheDir := filepath.Join(outAbs, compareRunsCacheDir)
+	if err := ensureDir(cacheDir); err != nil {
+		return err
+	}
+	fmt.Printf("📈 Comparing the last %d commit(s)...\n", len(runs))
+	var scored []RunScore
+	for _, run := range runs {
+		cachePath := filepath.Join(cacheDir, run.Commit+".json")
+		if cached, ok := readRunScore(cachePath); ok {
+			fmt.Printf("♻️  %s %d/100 (cached)\n", run.Short, cached.FinalScore)
+			scored = append(scored, cached)
+			continue
+		}
+		if err := evaluateCommit(&run, projectRoot, strings.TrimSpace(string(prefix))); err != nil {
+			fmt.Printf("⚠️  %s skipped: %v\n", run.Short, err)
+			continue
+		}
+		fmt.Printf("🏆 %s %d/100 (%s)\n", run.Short, run.FinalScore, run.Rating)
+		if data, err := json.MarshalIndent(run, "", "  "); err == nil {
+			if err := os.WriteFile(cachePath, append(data, '\n'), 0644); err != nil {
+				fmt.Printf("⚠️  could not cache %s: %v\n", run.Short, err)
+			}
+		}
+		scored = append(scored, run)
+	}
+	if len(scored) == 0 {
+		return fmt.Errorf("none of the %d commit(s) could be evaluated", len(runs))
+	}
+
+	path := filepath.Join(outAbs, "compare_runs.mmd.md")
+	if err := os.WriteFile(path, []byte(compareRunsMarkdown(scored)), 0644); err != nil {
+		return err
+	}
+	if err := convertMermaidFileToHTML(path); err != nil {
+		return fmt.Errorf("compare runs HTML: %w", err)
+	}
+	fmt.Printf("✅ Generated %s\n", strings.TrimSuffix(path, ".mmd.md")+".html")
+	return nil
+}
+
+// compareRunsCommits lists the last n commits that touch projectRoot, oldest first
+func compareRunsCommits(projectRoot string, n int) ([]RunScore, error) {
+	out, err := gitCommand(projectRoot, "log", "-n", fmt.Sprint(n), "--format=%H%x1f%h%x1f%cs%x1f%s", "--", ".").Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log: %w", err)
+	}
+	var runs []RunScore
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		fields := strings.SplitN(line, "\x1f", 4)
+		if len(fields) != 4 {
+			continue
+		}
+		runs = append(runs, RunScore{Commit: fields[0], Short: fields[1], Date: fields[2], Subject: fields[3]})
+	}
+	for i, j := 0, len(runs)-1; i < j; i, j = i+1, j-1 {
+		runs[i], runs[j] = runs[j], runs[i]
+	}
+	return runs, nil
+}
+
+// evaluateCommit checks run.Commit out into a temporary worktree and fills in its scores and counts
+func evaluateCommit(run *RunScore, projectRoot, prefix string) error {
+	return withGitWorktree(projectRoot, run.Commit, func(dir string) error {
+		projectDir := filepath.Join(dir, filepath.FromSlash(prefix))
+		status := ProjectEvaluator_AnalyzeProjectStatus(projectDir)
+		run.FinalScore, run.Rating = status.FinalScore, status.Rating
+		run.StructureScore, run.QualityScore = status.StructureScore, status.QualityScore
+
+		structure, err := Existing_scanProject(projectDir)
+		if err != nil {
+			return fmt.Errorf("scan: %w", err)
+		}
+		run.Functions = len(Existing_ownFunctions(structure))
+		run.Files = len(structure.Files)
+		run.Packages = len(structure.Packages)
+		return nil
+	})
+}
+
+// readRunScore loads a cached commit evaluation
+func readRunScore(path string) (RunScore, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return RunScore{}, false
+	}
+	var run RunScore
+	if err := json.Unmarshal(data, &run); err != nil || run.Commit == "" {
+		return RunScore{}, false
+	}
+	return run, true
+}
+
+// compareRunsMarkdown draws the final score per commit (oldest first) and tabulates the changes
+func compareRunsMarkdown(runs []RunScore) string {
+	var b strings.Builder
+	b.WriteString("# Compare Runs: Final Score over Git History\n\n")
+	first, last := runs[0], runs[len(runs)-1]
+	b.WriteString(fmt.Sprintf("**%d commit(s), %s to %s: final score %d → %d (%s).**\n\n",
+		len(runs), first.Short, last.Short, first.FinalScore, last.FinalScore, signedDelta(last.FinalScore-first.FinalScore)))
+
+	if mermaidSupports("xychart-beta") {
+		var labels, scores []string
+		for _, run := range runs {
+			labels = append(labels, fmt.Sprintf("%q", run.Short))
+			scores = append(scores, fmt.Sprint(run.FinalScore))
+		}
+		b.WriteString("```mermaid\n")
+		b.WriteString("xychart-beta\n")
+		b.WriteString("    title \"Final score per commit\"\n")
+		b.WriteString("    x-axis [" + strings.Join(labels, ", ") + "]\n")
+		b.WriteString("    y-axis \"Final score\" 0 --> 100\n")
+		b.WriteString("    line [" + strings.Join(scores, ", ") + "]\n")
+		b.WriteString("```\n\n")
+	} else {
+		b.WriteString(fmt.Sprintf("_The chart needs Mermaid %s+ (pinned %s); the table has the same numbers._\n\n",
+			mermaidFeatureMinVersion["xychart-beta"], mermaidVersion))
+	}
+
+	b.WriteString("| Commit | Date | Subject | Final | Δ | Rating | Structure | Quality | Functions | Δ | Files | Packages |\n")
+	b.WriteString("|--------|------|---------|-------|---|--------|-----------|---------|-----------|---|-------|----------|\n")
+	for i, run := range runs {
+		scoreDelta, funcDelta := "-", "-"
+		if i > 0 {
+			scoreDelta = signedDelta(run.FinalScore - runs[i-1].FinalScore)
+			funcDelta = signedDelta(run.Functions - runs[i-1].Functions)
+		}
+		b.WriteString(fmt.Sprintf("| `%s` | %s | %s | %d | %s | %s | %d | %d | %d | %s | %d | %d |\n",
+			run.Short, run.Date, strings.ReplaceAll(run.Subject, "|", "\\|"), run.FinalScore, scoreDelta, run.Rating,
+			run.StructureScore, run.QualityScore, run.Functions, funcDelta, run.Files, run.Packages))
+	}
+	return b.String()
+}
+
+// signedDelta formats a change with its sign: +3, -2 or 0
+func signedDelta(d int) string {
+	if d > 0 {
+		return fmt.Sprintf("+%d", d)
+	}
+	return fmt.Sprint(d)
+}
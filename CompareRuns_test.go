@@ -0,0 +1,35 @@
+//go:build flowcharts
+
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// Scores are plotted oldest first and each row shows the change since the previous commit
+func TestCompareRunsMarkdownDeltas(t *testing.T) {
+	content := compareRunsMarkdown([]RunScore{
+		{Commit: "a1", Short: "a1", Subject: "Scaffold | server", FinalScore: 40, Functions: 10},
+		{Commit: "b2", Short: "b2", Subject: "Add store", FinalScore: 55, Functions: 14},
+		{Commit: "c3", Short: "c3", Subject: "Refactor", FinalScore: 52, Functions: 12},
+	})
+	wants := []string{"| 55 | +15 |", "| 52 | -3 |", "| 12 | -2 |", "Scaffold \\| server", "40 → 52 (+12)"}
+	if mermaidSupports("xychart-beta") {
+		wants = append(wants, "line [40, 55, 52]")
+	}
+	for _, want := range wants {
+		if !strings.Contains(content, want) {
+			t.Errorf("compare runs is missing %q:\n%s", want, content)
+		}
+	}
+}
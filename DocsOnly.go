@@ -0,0 +1,132 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+DOCS ONLY - PURE-GO DOCUMENTATION FOR HERMETIC CONTAINERS
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: -docs-only is a preset for building docs where nothing but the
+             Go toolchain's own packages can be relied on. It keeps the
+             pure-Go generators (scan, dynamic reports, registered
+             generators, AI advisor diagrams) and turns off everything that
+             shells out or opens a window: go-callvis, goda, dot, goplantuml,
+             -typed (go/build may run `go list`) and the browser. JavaScript
+             for the package treemap is embedded (-offline). At the end it
+             writes the HTML chart pages, index.html and DIAGRAMS.md - a
+             Markdown table of contents of every diagram and report.
+
+TO USE THIS FILE:
+1. go run -tags flowcharts . -docs-only -out docs/architecture
+2. Commit or publish the folder; start from DIAGRAMS.md or index.html
+
+NOTES:
+- The .md/.mmd.md files are self-contained; the HTML chart pages still load
+  Mermaid.js from the CDN (-mermaid-version) when they are viewed
+- -docs-only cannot be combined with -interactive, -serve, -ref or -compare-runs
+- With several -root values every service gets its own DIAGRAMS.md and the
+  output directory a DIAGRAMS.md linking them; no browser is opened either
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// applyDocsOnly turns opts and the package settings into the -docs-only preset: pure-Go
+// generators only, no external tools, no browser
+func applyDocsOnly(opts *FlowchartOptions) {
+	opts.DocsOnly = true
+	opts.SkipOpen = true
+	opts.GenerateUML = false
+	typedMode = false
+	offlineMode = true
+}
+
+// writeDiagramsMarkdown writes <outDir>/DIAGRAMS.md linking every .mmd.md diagram and .md report
+// under outDir, titled by each file's first heading
+func writeDiagramsMarkdown(outDir string) error {
+	var diagrams, reports []string
+	err := filepath.WalkDir(outDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel := Existing_relSlash(outDir, path)
+		switch {
+		case strings.HasSuffix(rel, ".mmd.md"):
+			diagrams = append(diagrams, rel)
+		case strings.HasSuffix(rel, ".md") && rel != "DIAGRAMS.md":
+			reports = append(reports, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(diagrams)
+	sort.Strings(reports)
+
+	var b strings.Builder
+	b.WriteString("# Diagrams and Reports - Auto-Generated\n\n")
+	b.WriteString("Everything in this folder, generated from the source code by the pure-Go generators. ")
+	b.WriteString("`index.html` links the same files for a browser.\n\n")
+	for _, section := range []struct {
+		title string
+		files []string
+	}{
+		{"📊 Diagrams", diagrams},
+		{"📝 Reports", reports},
+	} {
+		if len(section.files) == 0 {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("## %s (%d)\n\n", section.title, len(section.files)))
+		for _, rel := range section.files {
+			title := markdownTitle(filepath.Join(outDir, filepath.FromSlash(rel)))
+			if title == "" {
+				b.WriteString(fmt.Sprintf("- [%s](%s)\n", rel, rel))
+			} else {
+				b.WriteString(fmt.Sprintf("- [%s](%s) - %s\n", rel, rel, title))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	return os.WriteFile(filepath.Join(outDir, "DIAGRAMS.md"), []byte(b.String()), 0644)
+}
+
+// markdownTitle returns the text of the first "# " heading in the first lines of a Markdown file, or ""
+func markdownTitle(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for i := 0; i < 5 && scanner.Scan(); i++ {
+		if title, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "# "); ok {
+			return strings.TrimSpace(title)
+		}
+	}
+	return ""
+}
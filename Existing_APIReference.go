@@ -0,0 +1,281 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING API REFERENCE - EXPORTED SYMBOLS ONLY (-api-only)
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: For library authors this file writes an API reference limited to
+             what importers can use: exported functions, exported types and the
+             exported methods of those types. Each entry shows its signature
+             and the first sentence of its doc comment. Unexported symbols,
+             package main and internal/ packages are left out entirely because
+             no other module can import them.
+
+TO USE THIS FILE:
+1. Run with -api-only (the rest of the pipeline is skipped)
+2. Open <out>/API_REFERENCE.md
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// apiSymbol is one exported function, type or method
+type apiSymbol struct {
+	Name      string
+	Signature string
+	Doc       string
+	File      string
+	Line      int
+	Methods   []apiSymbol // exported methods, for types
+}
+
+// apiPackage is the exported surface of one package
+type apiPackage struct {
+	Name      string
+	Path      string // directory relative to the module root
+	Functions []apiSymbol
+	Types     []apiSymbol
+}
+
+// Existing_WriteAPIReference scans root for exported symbols and writes API_REFERENCE.md
+func Existing_WriteAPIReference(outDir, root string) error {
+	packages, err := Existing_scanAPI(root)
+	if err != nil {
+		return fmt.Errorf("scan exported API: %w", err)
+	}
+
+	module := readModulePath(filepath.Join(root, "go.mod"))
+	var b strings.Builder
+	b.WriteString("# API Reference - Auto-Generated\n\n")
+	b.WriteString("Exported functions, types and methods only. Unexported symbols, `package main` and `internal/` packages are not part of the public API and are excluded.\n\n")
+
+	if len(packages) == 0 {
+		b.WriteString("_No importable packages with exported symbols found._\n")
+	} else {
+		b.WriteString("## Packages\n\n")
+		anchors := make(map[string]int)
+		for _, pkg := range packages {
+			b.WriteString(fmt.Sprintf("- [%s](#%s) - %d functions, %d types\n", Existing_apiImportPath(module, pkg), Existing_apiAnchor(anchors, pkg), len(pkg.Functions), len(pkg.Types)))
+		}
+		b.WriteString("\n")
+	}
+
+	for _, pkg := range packages {
+		b.WriteString(fmt.Sprintf("## package %s\n\n", pkg.Name))
+		b.WriteString(fmt.Sprintf("`import \"%s\"`\n\n", Existing_apiImportPath(module, pkg)))
+		if len(pkg.Functions) > 0 {
+			b.WriteString("### Functions\n\n")
+			for _, fn := range pkg.Functions {
+				Existing_writeAPISymbol(&b, "####", fn)
+			}
+		}
+		if len(pkg.Types) > 0 {
+			b.WriteString("### Types\n\n")
+			for _, typ := range pkg.Types {
+				Existing_writeAPISymbol(&b, "####", typ)
+				for _, method := range typ.Methods {
+					Existing_writeAPISymbol(&b, "#####", method)
+				}
+			}
+		}
+	}
+
+	if err := ensureDir(outDir); err != nil {
+		return err
+	}
+	path := filepath.Join(outDir, "API_REFERENCE.md")
+	if err := os.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("📘 API reference written to %s (%d packages)\n", path, len(packages))
+	return nil
+}
+
+// Existing_writeAPISymbol writes one symbol heading with its signature and doc summary
+func Existing_writeAPISymbol(b *strings.Builder, heading string, sym apiSymbol) {
+	b.WriteString(fmt.Sprintf("%s %s\n\n", heading, sym.Name))
+	b.WriteString("```go\n" + sym.Signature + "\n```\n\n")
+	if sym.Doc != "" {
+		b.WriteString(sym.Doc + "\n\n")
+	}
+	b.WriteString(fmt.Sprintf("📍 `%s:%d`\n\n", sym.File, sym.Line))
+}
+
+// Existing_scanAPI collects the exported symbols of every importable package under root
+func Existing_scanAPI(root string) ([]apiPackage, error) {
+	byDir := make(map[string]*apiPackage)
+	methods := make(map[string][]apiSymbol) // "dir.Type" -> exported methods
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			name := info.Name()
+			if Existing_skipScanDir(root, path, name) || name == "internal" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		fset := token.NewFileSet()
+		node, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		if node.Name.Name == "main" {
+			return nil
+		}
+		// Drop everything unexported: declarations, struct fields and interface methods
+		ast.FileExports(node)
+
+		dir := Existing_relSlash(root, filepath.Dir(path))
+		pkg := byDir[dir]
+		if pkg == nil {
+			pkg = &apiPackage{Name: node.Name.Name, Path: dir}
+			byDir[dir] = pkg
+		}
+		file := Existing_relSlash(root, path)
+
+		for _, decl := range node.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				sym := apiSymbol{
+					Name:      d.Name.Name,
+					Signature: Existing_funcSignature(fset, d),
+					Doc:       Existing_docSummary(d.Doc),
+					File:      file,
+					Line:      fset.Position(d.Pos()).Line,
+				}
+				if d.Recv == nil {
+					pkg.Functions = append(pkg.Functions, sym)
+					continue
+				}
+				recv := Existing_receiverName(d.Recv)
+				if ast.IsExported(recv) {
+					methods[dir+"."+recv] = append(methods[dir+"."+recv], sym)
+				}
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					ts := spec.(*ast.TypeSpec)
+					doc := ts.Doc
+					if doc == nil && len(d.Specs) == 1 {
+						doc = d.Doc
+					}
+					pkg.Types = append(pkg.Types, apiSymbol{
+						Name:      ts.Name.Name,
+						Signature: Existing_typeSignature(fset, ts),
+						Doc:       Existing_docSummary(doc),
+						File:      file,
+						Line:      fset.Position(ts.Pos()).Line,
+					})
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []apiPackage
+	for dir, pkg := range byDir {
+		for i := range pkg.Types {
+			pkg.Types[i].Methods = methods[dir+"."+pkg.Types[i].Name]
+			sort.Slice(pkg.Types[i].Methods, func(a, b int) bool { return pkg.Types[i].Methods[a].Name < pkg.Types[i].Methods[b].Name })
+		}
+		if len(pkg.Functions) == 0 && len(pkg.Types) == 0 {
+			continue
+		}
+		sort.Slice(pkg.Functions, func(a, b int) bool { return pkg.Functions[a].Name < pkg.Functions[b].Name })
+		sort.Slice(pkg.Types, func(a, b int) bool { return pkg.Types[a].Name < pkg.Types[b].Name })
+		packages = append(packages, *pkg)
+	}
+	sort.Slice(packages, func(i, j int) bool { return packages[i].Path < packages[j].Path })
+	return packages, nil
+}
+
+// Existing_typeSignature prints a type declaration without its doc comment
+func Existing_typeSignature(fset *token.FileSet, spec *ast.TypeSpec) string {
+	header := *spec
+	header.Doc, header.Comment = nil, nil
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, &ast.GenDecl{Tok: token.TYPE, Specs: []ast.Spec{&header}}); err != nil {
+		return "type " + spec.Name.Name
+	}
+	return buf.String()
+}
+
+// Existing_receiverName returns the type name of a method receiver, without pointer or type parameters
+func Existing_receiverName(recv *ast.FieldList) string {
+	if recv == nil || len(recv.List) == 0 {
+		return ""
+	}
+	expr := recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch t := expr.(type) {
+	case *ast.IndexExpr:
+		expr = t.X
+	case *ast.IndexListExpr:
+		expr = t.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// Existing_apiImportPath returns the import path of a package ("." is the module itself)
+func Existing_apiImportPath(module string, pkg apiPackage) string {
+	if module == "" {
+		return pkg.Path
+	}
+	if pkg.Path == "." {
+		return module
+	}
+	return module + "/" + pkg.Path
+}
+
+// Existing_apiAnchor returns the Markdown anchor GitHub generates for a package heading;
+// repeated package names get -1, -2, ... in heading order
+func Existing_apiAnchor(seen map[string]int, pkg apiPackage) string {
+	anchor := "package-" + strings.ToLower(pkg.Name)
+	n := seen[anchor]
+	seen[anchor]++
+	if n > 0 {
+		return fmt.Sprintf("%s-%d", anchor, n)
+	}
+	return anchor
+}
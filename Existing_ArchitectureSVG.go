@@ -0,0 +1,165 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING ARCHITECTURE SVG - ONE-SCREEN LAYERED OVERVIEW
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file hand-writes a small static SVG of the project's layers
+             (Client -> API -> App -> Store -> DB). Only layers that exist in
+             the scanned project are drawn, each with its file and function
+             counts. The SVG needs no renderer, so it can be dropped straight
+             into a wiki or README.
+
+TO USE THIS FILE:
+1. Runs with the other dynamic reports after the project scan
+2. Open Existing_architecture.svg in any browser or image viewer
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// architectureSVGLayer is one box of the layered overview
+type architectureSVGLayer struct {
+	Name  string
+	Hint  string
+	Fill  string
+	Match func(file, function string) bool // reports whether a function belongs to the layer
+}
+
+// architectureSVGLayers are drawn top to bottom in this order
+var architectureSVGLayers = []architectureSVGLayer{
+	{"API", "handlers, routes, middleware", "#fce4ec", func(file, function string) bool {
+		return Existing_pathHasDir(file, "api", "handlers", "routes", "middleware") || strings.HasPrefix(function, "Handle")
+	}},
+	{"App", "wiring and startup", "#e8f5e9", func(file, function string) bool {
+		return Existing_pathHasDir(file, "app") || function == "NewApplication"
+	}},
+	{"Store", "data access", "#f3e5f5", func(file, function string) bool {
+		return Existing_pathHasDir(file, "store", "repository", "repo")
+	}},
+	{"DB", "connection and migrations", "#e3f2fd", func(file, function string) bool {
+		lower := strings.ToLower(function)
+		return Existing_pathHasDir(file, "database", "db", "migrations") || strings.Contains(lower, "migrate") || strings.HasPrefix(lower, "opendb")
+	}},
+}
+
+// Existing_WriteArchitectureSVG draws the project's layers as a static one-screen SVG
+func Existing_WriteArchitectureSVG(outDir string, structure *ProjectStructure) error {
+	type layerCount struct {
+		layer     architectureSVGLayer
+		files     map[string]bool
+		functions int
+	}
+	counts := make([]*layerCount, len(architectureSVGLayers))
+	for i, layer := range architectureSVGLayers {
+		counts[i] = &layerCount{layer: layer, files: make(map[string]bool)}
+	}
+	// Each function counts towards the first layer it matches
+	for _, fn := range structure.Functions {
+		for _, count := range counts {
+			if count.layer.Match(fn.File, fn.Name) {
+				count.files[fn.File] = true
+				count.functions++
+				break
+			}
+		}
+	}
+	var present []*layerCount
+	for _, count := range counts {
+		if count.functions > 0 {
+			present = append(present, count)
+		}
+	}
+
+	const (
+		width   = 480
+		boxW    = 360
+		boxH    = 56
+		gap     = 32
+		marginY = 56
+	)
+	boxes := len(present)
+	hasClient := boxes > 0 && present[0].layer.Name == "API" // an HTTP API implies clients
+	if hasClient {
+		boxes++
+	}
+	height := marginY + boxes*(boxH+gap) - gap + 24
+	if boxes == 0 {
+		height = marginY + boxH + 24
+	}
+	x := (width - boxW) / 2
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" viewBox=\"0 0 %d %d\" font-family=\"Segoe UI, Helvetica, Arial, sans-serif\">\n", width, height, width, height))
+	b.WriteString("  <defs><marker id=\"arrow\" viewBox=\"0 0 10 10\" refX=\"5\" refY=\"5\" markerWidth=\"8\" markerHeight=\"8\" orient=\"auto\"><path d=\"M0,0 L10,5 L0,10 z\" fill=\"#546e7a\"/></marker></defs>\n")
+	b.WriteString(fmt.Sprintf("  <rect width=\"%d\" height=\"%d\" fill=\"#ffffff\"/>\n", width, height))
+	b.WriteString(fmt.Sprintf("  <text x=\"%d\" y=\"32\" text-anchor=\"middle\" font-size=\"18\" font-weight=\"bold\" fill=\"#263238\">%s</text>\n", width/2, html.EscapeString(Existing_architectureTitle(structure))))
+
+	y := marginY
+	box := func(title, subtitle, fill string) {
+		b.WriteString(fmt.Sprintf("  <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" rx=\"8\" fill=\"%s\" stroke=\"#546e7a\" stroke-width=\"1.5\"/>\n", x, y, boxW, boxH, fill))
+		b.WriteString(fmt.Sprintf("  <text x=\"%d\" y=\"%d\" text-anchor=\"middle\" font-size=\"16\" font-weight=\"bold\" fill=\"#263238\">%s</text>\n", width/2, y+24, html.EscapeString(title)))
+		b.WriteString(fmt.Sprintf("  <text x=\"%d\" y=\"%d\" text-anchor=\"middle\" font-size=\"12\" fill=\"#546e7a\">%s</text>\n", width/2, y+44, html.EscapeString(subtitle)))
+		y += boxH + gap
+	}
+	arrow := func() {
+		b.WriteString(fmt.Sprintf("  <line x1=\"%d\" y1=\"%d\" x2=\"%d\" y2=\"%d\" stroke=\"#546e7a\" stroke-width=\"2\" marker-end=\"url(#arrow)\"/>\n", width/2, y-gap+2, width/2, y-6))
+	}
+
+	if boxes == 0 {
+		box("No layers detected", fmt.Sprintf("%d functions in %d files", len(structure.Functions), len(structure.Files)), "#eceff1")
+	}
+	if hasClient {
+		box("Client", "HTTP requests", "#fff8e1")
+	}
+	for i, count := range present {
+		if i > 0 || hasClient {
+			arrow()
+		}
+		box(count.layer.Name, fmt.Sprintf("%s · %d files · %d functions", count.layer.Hint, len(count.files), count.functions), count.layer.Fill)
+	}
+	b.WriteString("</svg>\n")
+
+	path := filepath.Join(outDir, "Existing_architecture.svg")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_architectureTitle names the SVG after the project root folder
+func Existing_architectureTitle(structure *ProjectStructure) string {
+	if structure.Root == "" {
+		return "Architecture Overview"
+	}
+	return filepath.Base(structure.Root) + " - Architecture Overview"
+}
+
+// Existing_pathHasDir reports whether a slash-separated relative path has one of dirs as a directory segment
+func Existing_pathHasDir(file string, dirs ...string) bool {
+	segments := strings.Split(strings.ToLower(file), "/")
+	for _, segment := range segments[:len(segments)-1] {
+		for _, dir := range dirs {
+			if segment == dir {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,183 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING AUXILIARY FILES - SQL MIGRATIONS, DOCKER COMPOSE AND .ENV KEYS
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The function scan only reads .go files, but migrations,
+             docker-compose and config are part of the architecture too.
+             scanAuxiliary records them lightly - no YAML or SQL parser:
+             .sql migrations with the version number from their file name
+             (00001_users.sql, 20240101120000_add_email.up.sql), the services
+             of docker-compose*.yml files and the keys (never the values) of
+             .env files. The result is ProjectStructure.Aux, so diagrams can
+             name the real files instead of canned examples.
+
+TO USE THIS FILE:
+1. Existing_scanProject fills structure.Aux automatically
+2. Or call scanAuxiliary(root) directly
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// migrationName matches versioned migration file names: goose (00001_users.sql)
+// and golang-migrate (20240101120000_add_email.up.sql)
+var migrationName = regexp.MustCompile(`^([0-9]+)_.+\.sql$`)
+
+// MigrationFile is one .sql migration; Version is 0 when the name has no numeric prefix
+type MigrationFile struct {
+	File    string
+	Version int64
+}
+
+// ComposeService is one service of a docker-compose file
+type ComposeService struct {
+	File  string
+	Name  string
+	Image string // "" when the service is built from a Dockerfile
+}
+
+// AuxFiles are the non-Go files that shape the architecture
+type AuxFiles struct {
+	Migrations []MigrationFile  // sorted by version, then file
+	Services   []ComposeService // in file order
+	EnvFiles   []string
+	EnvKeys    []string // sorted and unique; values are never recorded
+}
+
+// scanAuxiliary records the migrations, docker-compose services and .env keys under root
+func scanAuxiliary(root string) AuxFiles {
+	var aux AuxFiles
+	envKeys := make(map[string]bool)
+	filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		name := info.Name()
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, name) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel := Existing_relSlash(root, path)
+		switch {
+		case strings.HasSuffix(name, ".sql"):
+			match := migrationName.FindStringSubmatch(name)
+			if match == nil && !strings.Contains(strings.ToLower(rel), "migrations/") {
+				return nil
+			}
+			migration := MigrationFile{File: rel}
+			if match != nil {
+				migration.Version, _ = strconv.ParseInt(match[1], 10, 64)
+			}
+			aux.Migrations = append(aux.Migrations, migration)
+		case strings.HasPrefix(name, "docker-compose") && (strings.HasSuffix(name, ".yml") || strings.HasSuffix(name, ".yaml")):
+			aux.Services = append(aux.Services, composeServices(path, rel)...)
+		case name == ".env" || strings.HasPrefix(name, ".env."):
+			aux.EnvFiles = append(aux.EnvFiles, rel)
+			for _, key := range envFileKeys(path) {
+				envKeys[key] = true
+			}
+		}
+		return nil
+	})
+
+	sort.SliceStable(aux.Migrations, func(i, j int) bool {
+		if aux.Migrations[i].Version != aux.Migrations[j].Version {
+			return aux.Migrations[i].Version < aux.Migrations[j].Version
+		}
+		return aux.Migrations[i].File < aux.Migrations[j].File
+	})
+	for key := range envKeys {
+		aux.EnvKeys = append(aux.EnvKeys, key)
+	}
+	sort.Strings(aux.EnvKeys)
+	return aux
+}
+
+// composeServices reads the service names and images of a docker-compose file line by line:
+// the keys one level below a top-level "services:" and their "image:" values
+func composeServices(path, rel string) []ComposeService {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var services []ComposeService
+	inServices := false
+	serviceIndent := -1
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		if indent == 0 {
+			inServices = trimmed == "services:"
+			serviceIndent = -1
+			continue
+		}
+		if !inServices {
+			continue
+		}
+		if serviceIndent < 0 {
+			serviceIndent = indent
+		}
+		if indent == serviceIndent && strings.HasSuffix(trimmed, ":") {
+			services = append(services, ComposeService{File: rel, Name: strings.Trim(strings.TrimSuffix(trimmed, ":"), `"'`)})
+			continue
+		}
+		if image, ok := strings.CutPrefix(trimmed, "image:"); ok && len(services) > 0 && indent > serviceIndent {
+			services[len(services)-1].Image = strings.Trim(strings.TrimSpace(image), `"'`)
+		}
+	}
+	return services
+}
+
+// envFileKeys returns the variable names assigned in a .env file (KEY=value or export KEY=value)
+func envFileKeys(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var keys []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		if key, _, ok := strings.Cut(line, "="); ok {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
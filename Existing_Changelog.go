@@ -0,0 +1,161 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING CHANGELOG - FUNCTIONS ADDED/REMOVED ACROSS RUNS
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file keeps a human-readable log of how the function
+             inventory changes over time. Each run compares the scanned
+             functions with the set stored by the previous run and appends a
+             dated section listing new and removed functions.
+
+TO USE THIS FILE:
+1. Call Existing_AppendInventoryChangelog() after scanning the project
+2. Keep the output folder between runs - the previous set lives there
+
+FEATURES:
+- Existing_function_changelog.md - Dated log of added/removed functions
+- Existing_function_set.json - Raw function set of the last run (sidecar)
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Existing_AppendInventoryChangelog diffs the scanned functions against the previous run and appends the changes
+func Existing_AppendInventoryChangelog(outDir string, structure *ProjectStructure) error {
+	setPath := filepath.Join(outDir, "Existing_function_set.json")
+	logPath := filepath.Join(outDir, "Existing_function_changelog.md")
+
+	current := Existing_functionSet(structure)
+
+	// Load the set stored by the previous run, if any
+	var previous []string
+	firstRun := true
+	if data, err := os.ReadFile(setPath); err == nil {
+		if err := json.Unmarshal(data, &previous); err != nil {
+			return fmt.Errorf("parse %s: %w", setPath, err)
+		}
+		firstRun = false
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	added, removed := Existing_diffFunctionSets(previous, current)
+
+	var section strings.Builder
+	timestamp := time.Now().Format("2006-01-02 15:04")
+	switch {
+	case firstRun:
+		section.WriteString(fmt.Sprintf("## %s - Baseline\n\n", timestamp))
+		section.WriteString(fmt.Sprintf("Recorded %d functions as the starting point.\n\n", len(current)))
+	case len(added) == 0 && len(removed) == 0:
+		fmt.Println("ℹ️  Function changelog: no functions added or removed since the last run")
+	default:
+		section.WriteString(fmt.Sprintf("## %s\n\n", timestamp))
+		section.WriteString(fmt.Sprintf("**Added:** %d  |  **Removed:** %d  |  **Total:** %d\n\n", len(added), len(removed), len(current)))
+		if len(added) > 0 {
+			section.WriteString("### ➕ New Functions\n\n")
+			for _, name := range added {
+				section.WriteString(fmt.Sprintf("- `%s`\n", name))
+			}
+			section.WriteString("\n")
+		}
+		if len(removed) > 0 {
+			section.WriteString("### ➖ Removed Functions\n\n")
+			for _, name := range removed {
+				section.WriteString(fmt.Sprintf("- `%s`\n", name))
+			}
+			section.WriteString("\n")
+		}
+	}
+
+	if section.Len() > 0 {
+		if _, err := os.Stat(logPath); os.IsNotExist(err) {
+			header := "# Existing Function Changelog - Auto-Generated\n\n" +
+				"Functions added and removed between runs, newest at the bottom.\n\n"
+			if err := os.WriteFile(logPath, []byte(header), 0644); err != nil {
+				return err
+			}
+		}
+		f, err := os.OpenFile(logPath, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		if _, err := f.WriteString(section.String()); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+
+	// Store the current set for the next run
+	data, err := json.MarshalIndent(current, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal function set: %w", err)
+	}
+	return os.WriteFile(setPath, data, 0644)
+}
+
+// Existing_functionSet returns the sorted, de-duplicated identities of all scanned functions
+func Existing_functionSet(structure *ProjectStructure) []string {
+	seen := make(map[string]bool)
+	var set []string
+	for _, fn := range structure.Functions {
+		id := fn.Package + "." + fn.Name
+		if fn.Receiver != "" {
+			id = fn.Package + "." + fn.Receiver + "." + fn.Name
+		}
+		if !seen[id] {
+			seen[id] = true
+			set = append(set, id)
+		}
+	}
+	sort.Strings(set)
+	return set
+}
+
+// Existing_diffFunctionSets returns the names only in current (added) and only in previous (removed)
+func Existing_diffFunctionSets(previous, current []string) (added, removed []string) {
+	before := make(map[string]bool, len(previous))
+	for _, name := range previous {
+		before[name] = true
+	}
+	after := make(map[string]bool, len(current))
+	for _, name := range current {
+		after[name] = true
+		if !before[name] {
+			added = append(added, name)
+		}
+	}
+	for _, name := range previous {
+		if !after[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
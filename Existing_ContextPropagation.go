@@ -0,0 +1,282 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING CONTEXT PROPAGATION - WHERE THE REQUEST CONTEXT GETS DROPPED
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: A cancelled request should cancel its queries. That only works
+             when every function that has a context passes it down. This file
+             checks the functions that have one - a context.Context parameter,
+             or an *http.Request whose r.Context() is at hand in handlers -
+             and flags three ways the chain breaks:
+               1. a fresh context.Background() / context.TODO() is passed on
+               2. a database/sql or net/http call without its Context variant
+                  (Query instead of QueryContext, http.NewRequest, ...)
+               3. a project function is called that does such I/O but takes
+                  no context at all - e.g. a handler calling a store method
+                  that should accept ctx
+             AST heuristics only: project functions are matched by name.
+
+TO USE THIS FILE:
+1. Runs automatically as the "context-propagation" registered generator
+2. Read Existing_context_propagation.md - offenders with file:line
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// contextVariants maps database/sql methods to the variant that takes a context
+var contextVariants = map[string]string{
+	"Query":    "QueryContext",
+	"QueryRow": "QueryRowContext",
+	"Exec":     "ExecContext",
+	"Prepare":  "PrepareContext",
+	"Begin":    "BeginTx",
+	"Ping":     "PingContext",
+}
+
+// httpContextVariants maps net/http functions to their context-aware replacement
+var httpContextVariants = map[string]string{
+	"http.NewRequest": "http.NewRequestWithContext",
+	"http.Get":        "http.NewRequestWithContext + Do",
+	"http.Post":       "http.NewRequestWithContext + Do",
+	"http.Head":       "http.NewRequestWithContext + Do",
+}
+
+// contextOffender is one call that breaks the context chain
+type contextOffender struct {
+	Function string
+	File     string
+	Line     int
+	Call     string
+	Problem  string
+}
+
+// contextPropagationGenerator runs Existing_WriteContextPropagationReport from the generator registry
+type contextPropagationGenerator struct{}
+
+func init() { Register(contextPropagationGenerator{}) }
+
+func (contextPropagationGenerator) Name() string { return "context-propagation" }
+
+func (contextPropagationGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	return Existing_WriteContextPropagationReport(cfg.OutDir, cfg.Root)
+}
+
+// Existing_WriteContextPropagationReport lists the calls under root that drop an available context
+func Existing_WriteContextPropagationReport(outDir, root string) error {
+	offenders, err := Existing_findContextOffenders(root)
+	if err != nil {
+		return fmt.Errorf("context propagation: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("# Existing Context Propagation - Auto-Generated\n\n")
+	b.WriteString("Functions that have a `context.Context` (a parameter, or `r.Context()` in HTTP handlers) but call downstream code without forwarding it. AST heuristics: project functions are matched by name.\n\n")
+	if len(offenders) == 0 {
+		b.WriteString("✅ No dropped contexts found.\n")
+	} else {
+		b.WriteString(fmt.Sprintf("**Offenders:** %d\n\n", len(offenders)))
+		b.WriteString("| Function | Location | Call | Problem |\n")
+		b.WriteString("|----------|----------|------|---------|\n")
+		for _, o := range offenders {
+			b.WriteString(fmt.Sprintf("| `%s` | `%s:%d` | `%s` | %s |\n", o.Function, o.File, o.Line, o.Call, o.Problem))
+		}
+	}
+
+	path := filepath.Join(outDir, "Existing_context_propagation.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_findContextOffenders parses the Go files under root twice: first to learn which project
+// functions take a context and which do context-capable I/O without one, then to check the calls
+// made by every function that has a context
+func Existing_findContextOffenders(root string) ([]contextOffender, error) {
+	type parsedFile struct {
+		rel  string
+		fset *token.FileSet
+		node *ast.File
+	}
+	var files []parsedFile
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		fset := token.NewFileSet()
+		node, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil // unparsable files do not block the report
+		}
+		files = append(files, parsedFile{rel: Existing_relSlash(root, path), fset: fset, node: node})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Pass 1: project functions that take a context, and those doing I/O without one
+	takesCtx := make(map[string]bool)
+	needsCtx := make(map[string]bool)
+	for _, f := range files {
+		for _, decl := range f.node.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			if _, ok := Existing_contextSource(fn); ok {
+				takesCtx[fn.Name.Name] = true
+				continue
+			}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok {
+					if _, ok := Existing_contextVariant(call); ok {
+						needsCtx[fn.Name.Name] = true
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	// Pass 2: calls made by the functions that do have a context
+	var offenders []contextOffender
+	for _, f := range files {
+		for _, decl := range f.node.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			source, ok := Existing_contextSource(fn)
+			if !ok {
+				continue
+			}
+			name := fn.Name.Name
+			if fn.Recv != nil && len(fn.Recv.List) > 0 {
+				name = strings.TrimPrefix(types.ExprString(fn.Recv.List[0].Type), "*") + "." + name
+			}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				if _, isLit := call.Fun.(*ast.FuncLit); isLit {
+					return true
+				}
+				callee := types.ExprString(call.Fun)
+				add := func(problem string) {
+					offenders = append(offenders, contextOffender{
+						Function: name,
+						File:     f.rel,
+						Line:     f.fset.Position(call.Pos()).Line,
+						Call:     callee + "()",
+						Problem:  problem,
+					})
+				}
+				for _, arg := range call.Args {
+					if expr := types.ExprString(arg); expr == "context.Background()" || expr == "context.TODO()" {
+						add(fmt.Sprintf("passes `%s` instead of `%s`", expr, source))
+						return true
+					}
+				}
+				if variant, ok := Existing_contextVariant(call); ok {
+					add(fmt.Sprintf("no context; use `%s` with `%s`", variant, source))
+					return true
+				}
+				if calleeName := Existing_calleeName(call); needsCtx[calleeName] && !takesCtx[calleeName] {
+					add(fmt.Sprintf("`%s` does I/O but takes no context - the context stops here", calleeName))
+				}
+				return true
+			})
+		}
+	}
+
+	sort.SliceStable(offenders, func(i, j int) bool {
+		if offenders[i].File != offenders[j].File {
+			return offenders[i].File < offenders[j].File
+		}
+		return offenders[i].Line < offenders[j].Line
+	})
+	return offenders, nil
+}
+
+// Existing_contextSource returns the expression that yields fn's context: its context.Context
+// parameter, or r.Context() for an *http.Request parameter
+func Existing_contextSource(fn *ast.FuncDecl) (string, bool) {
+	request := ""
+	for _, field := range fn.Type.Params.List {
+		typ := types.ExprString(field.Type)
+		for _, paramName := range field.Names {
+			if paramName.Name == "_" {
+				continue
+			}
+			switch typ {
+			case "context.Context":
+				return paramName.Name, true
+			case "*http.Request":
+				if request == "" {
+					request = paramName.Name + ".Context()"
+				}
+			}
+		}
+	}
+	return request, request != ""
+}
+
+// Existing_contextVariant reports whether call is a database/sql method or net/http function
+// that has a context-aware variant, and returns that variant
+func Existing_contextVariant(call *ast.CallExpr) (string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+	if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "http" {
+		variant, ok := httpContextVariants[types.ExprString(sel)]
+		return variant, ok
+	}
+	variant, ok := contextVariants[sel.Sel.Name]
+	return variant, ok
+}
+
+// Existing_calleeName returns the function or method name a call expression invokes
+func Existing_calleeName(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	}
+	return ""
+}
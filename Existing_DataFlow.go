@@ -0,0 +1,333 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING DATA FLOW - ROUTE -> HANDLER -> STORE -> TABLE TRACEABILITY
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file stitches three views of the project into one end-to-end
+             diagram: the HTTP routes registered in code, the call graph from
+             each handler down to the store methods it reaches, and the SQL
+             tables those store methods query (from the SQL inventory). Every
+             link that cannot be resolved - a handler passed as a variable, a
+             handler that reaches no store, a store method without SQL - is
+             drawn as a dashed edge to a "?" node so gaps stay visible.
+
+TO USE THIS FILE:
+1. Runs automatically as the "data-flow" registered generator
+2. Or call Existing_WriteDataFlowDiagram(outDir, root, structure) directly
+
+FEATURES:
+- Existing_data_flow.mmd.md - Route -> Handler -> Store method -> SQL table
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// httpRoute is one route registration found in the code
+type httpRoute struct {
+	Method  string // GET, POST, ... or ANY for Handle/HandleFunc
+	Pattern string // full path including Route() prefixes
+	Handler string // bare function name of the handler, "" when it is not a named function
+	File    string
+	Line    int
+}
+
+// routeMethods maps router method names to HTTP methods
+var routeMethods = map[string]string{
+	"Get": "GET", "Post": "POST", "Put": "PUT", "Patch": "PATCH", "Delete": "DELETE",
+	"Head": "HEAD", "Options": "OPTIONS", "Handle": "ANY", "HandleFunc": "ANY",
+}
+
+// dataFlowMaxDepth bounds how many helper calls are followed from a handler to a store method
+const dataFlowMaxDepth = 3
+
+// dataFlowGenerator runs Existing_WriteDataFlowDiagram from the generator registry
+type dataFlowGenerator struct{}
+
+func init() { Register(dataFlowGenerator{}) }
+
+func (dataFlowGenerator) Name() string { return "data-flow" }
+
+func (dataFlowGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	if structure == nil {
+		return nil
+	}
+	return Existing_WriteDataFlowDiagram(cfg.OutDir, cfg.Root, structure)
+}
+
+// Existing_WriteDataFlowDiagram draws Route -> Handler -> Store method -> SQL table, with dashed "?" edges for gaps
+func Existing_WriteDataFlowDiagram(outDir, root string, structure *ProjectStructure) error {
+	routes, err := Existing_findRoutes(root)
+	if err != nil {
+		return fmt.Errorf("find routes: %w", err)
+	}
+	edges, err := Existing_callEdges(structure)
+	if err != nil {
+		return fmt.Errorf("call graph: %w", err)
+	}
+	queries, err := Existing_scanSQLQueries(root)
+	if err != nil {
+		return fmt.Errorf("SQL inventory: %w", err)
+	}
+
+	// Store method -> tables it queries; the inventory names methods Type.Method
+	tables := make(map[string][]string)
+	for _, q := range queries {
+		name := q.Function[strings.LastIndex(q.Function, ".")+1:]
+		if q.Table != "(unknown)" && !slices.Contains(tables[name], q.Table) {
+			tables[name] = append(tables[name], q.Table)
+		}
+	}
+	isStore := make(map[string]bool)
+	known := make(map[string]bool)
+	for _, fn := range structure.Functions {
+		known[fn.Name] = true
+		if Existing_pathHasDir(fn.File, "store", "repository", "repo") {
+			isStore[fn.Name] = true
+		}
+	}
+	for name := range tables {
+		isStore[name] = true
+	}
+
+	var b strings.Builder
+	b.WriteString("```mermaid\n")
+	b.WriteString("flowchart LR\n")
+	b.WriteString("    %% Route -> Handler -> Store method -> SQL table; dashed ? edges are links that could not be resolved\n")
+	b.WriteString("    classDef routeClass fill:#fff8e1,stroke:#f57c00,stroke-width:2px,color:#000\n")
+	b.WriteString("    classDef handlerClass fill:#fce4ec,stroke:#c2185b,stroke-width:2px,color:#000\n")
+	b.WriteString("    classDef storeClass fill:#f3e5f5,stroke:#7b1fa2,stroke-width:2px,color:#000\n")
+	b.WriteString("    classDef tableClass fill:#e3f2fd,stroke:#0277bd,stroke-width:2px,color:#000\n")
+	b.WriteString("    classDef unknownClass fill:#eeeeee,stroke:#9e9e9e,stroke-dasharray:4 4,color:#616161\n\n")
+
+	if len(routes) == 0 {
+		b.WriteString("    NoRoutes[\"No route registrations found\"]\n")
+	}
+
+	used := make(map[string]bool)
+	ids := make(map[string]string) // "kind:name" -> node ID, so shared nodes are drawn once
+	node := func(kind, name, shape string) string {
+		key := kind + ":" + name
+		if id, ok := ids[key]; ok {
+			return id
+		}
+		id := Existing_uniqueMermaidID(used, kind+"_"+name)
+		ids[key] = id
+		b.WriteString(fmt.Sprintf("    %s%s\n", id, fmt.Sprintf(shape, Existing_mermaidLabel(name))))
+		b.WriteString(fmt.Sprintf("    class %s %sClass\n", id, kind))
+		return id
+	}
+	unknown := func(from string) {
+		id := Existing_uniqueMermaidID(used, "unknown")
+		b.WriteString(fmt.Sprintf("    %s[\"?\"]\n    class %s unknownClass\n", id, id))
+		b.WriteString(fmt.Sprintf("    %s -.-> %s\n", from, id))
+	}
+	drawn := make(map[string]bool)
+	edge := func(from, to string) {
+		if !drawn[from+"->"+to] {
+			drawn[from+"->"+to] = true
+			b.WriteString(fmt.Sprintf("    %s --> %s\n", from, to))
+		}
+	}
+
+	handlersDone := make(map[string]bool)
+	for _, route := range routes {
+		routeID := node("route", route.Method+" "+route.Pattern, "[\"%s\"]")
+		if route.Handler == "" || !known[route.Handler] {
+			unknown(routeID)
+			continue
+		}
+		handlerID := node("handler", route.Handler, "[\"%s()\"]")
+		edge(routeID, handlerID)
+		if handlersDone[route.Handler] {
+			continue
+		}
+		handlersDone[route.Handler] = true
+
+		stores := Existing_reachableStores(route.Handler, edges, isStore)
+		if len(stores) == 0 {
+			unknown(handlerID)
+			continue
+		}
+		for _, store := range stores {
+			storeID := node("store", store, "[\"%s()\"]")
+			edge(handlerID, storeID)
+			if len(tables[store]) == 0 {
+				if !drawn[storeID+"->?"] {
+					drawn[storeID+"->?"] = true
+					unknown(storeID)
+				}
+				continue
+			}
+			for _, table := range tables[store] {
+				edge(storeID, node("table", table, "[(\"%s\")]"))
+			}
+		}
+	}
+	b.WriteString("```\n")
+
+	path := filepath.Join(outDir, "Existing_data_flow.mmd.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_reachableStores follows the call graph from handler through helper functions and
+// returns the store methods it reaches, sorted
+func Existing_reachableStores(handler string, edges map[string][]string, isStore map[string]bool) []string {
+	found := make(map[string]bool)
+	visited := map[string]bool{handler: true}
+	frontier := []string{handler}
+	for depth := 0; depth < dataFlowMaxDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, caller := range frontier {
+			for _, callee := range edges[caller] {
+				if visited[callee] {
+					continue
+				}
+				visited[callee] = true
+				if isStore[callee] {
+					found[callee] = true
+				} else {
+					next = append(next, callee)
+				}
+			}
+		}
+		frontier = next
+	}
+	stores := make([]string, 0, len(found))
+	for name := range found {
+		stores = append(stores, name)
+	}
+	sort.Strings(stores)
+	return stores
+}
+
+// Existing_findRoutes parses the Go files under root for router registrations such as
+// r.Get("/users", h.HandleGetUser) or mux.HandleFunc("/users", ...), joining Route() prefixes
+func Existing_findRoutes(root string) ([]httpRoute, error) {
+	var routes []httpRoute
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		fset := token.NewFileSet()
+		node, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil // a broken file only hides its own routes
+		}
+		Existing_collectRoutes(fset, node, "", Existing_relSlash(root, path), &routes)
+		return nil
+	})
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Pattern != routes[j].Pattern {
+			return routes[i].Pattern < routes[j].Pattern
+		}
+		return routes[i].Method < routes[j].Method
+	})
+	return routes, err
+}
+
+// Existing_collectRoutes records the route registrations in n under prefix, descending into Route closures
+func Existing_collectRoutes(fset *token.FileSet, n ast.Node, prefix, file string, routes *[]httpRoute) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		pattern, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+
+		if sel.Sel.Name == "Route" {
+			if body, ok := call.Args[len(call.Args)-1].(*ast.FuncLit); ok {
+				Existing_collectRoutes(fset, body.Body, Existing_joinRoute(prefix, pattern), file, routes)
+				return false
+			}
+			return true
+		}
+		method, ok := routeMethods[sel.Sel.Name]
+		if !ok {
+			return true
+		}
+		// Go 1.22 ServeMux patterns carry the method: "GET /users/{id}"
+		if m, rest, found := strings.Cut(pattern, " "); found && method == "ANY" {
+			method, pattern = m, strings.TrimSpace(rest)
+		}
+		if !strings.HasPrefix(pattern, "/") {
+			return true // q.Get("id", ...) and friends are not routes
+		}
+		*routes = append(*routes, httpRoute{
+			Method:  method,
+			Pattern: Existing_joinRoute(prefix, pattern),
+			Handler: Existing_handlerName(call.Args[len(call.Args)-1]),
+			File:    file,
+			Line:    fset.Position(call.Pos()).Line,
+		})
+		return true
+	})
+}
+
+// Existing_joinRoute joins a Route() prefix and a pattern without doubling slashes
+func Existing_joinRoute(prefix, pattern string) string {
+	if prefix == "" {
+		return pattern
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(pattern, "/")
+}
+
+// Existing_handlerName returns the function name a handler argument refers to, unwrapping
+// http.HandlerFunc(x) conversions; a plain variable has no resolvable name
+func Existing_handlerName(expr ast.Expr) string {
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) == 1 {
+		expr = call.Args[0]
+	}
+	switch h := expr.(type) {
+	case *ast.SelectorExpr:
+		return h.Sel.Name
+	case *ast.Ident:
+		return h.Name
+	}
+	return ""
+}
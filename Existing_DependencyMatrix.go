@@ -0,0 +1,189 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING DEPENDENCY MATRIX - PACKAGE IMPORTS AS AN ADJACENCY TABLE
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: For large projects a graph of package imports turns into a
+             hairball; a matrix stays readable. This file writes an HTML table
+             with one row and one column per package directory, where cell
+             [i][j] is marked when package i imports package j. Only imports
+             inside the module count. Mutual imports (i imports j and j
+             imports i) are cycles and are highlighted in red.
+
+TO USE THIS FILE:
+1. Runs with the other dynamic reports after the project scan
+2. Open Existing_dependency_matrix.html in a browser
+3. Or print the same edges as text: go run -tags flowcharts . -task imports
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"html"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Existing_WriteDependencyMatrix writes an HTML adjacency table of package imports, cycles in red
+func Existing_WriteDependencyMatrix(outDir string, structure *ProjectStructure) error {
+	packages, imports, err := Existing_packageImportEdges(structure)
+	if err != nil {
+		return fmt.Errorf("collect package imports: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Dependency Matrix</title>
+    <style>
+        body { font-family: Arial, sans-serif; margin: 20px; background: #f5f5f5; }
+        table { border-collapse: collapse; background: #fff; }
+        th, td { border: 1px solid #ccc; padding: 4px 6px; text-align: center; font-size: 12px; }
+        th.row { text-align: left; white-space: nowrap; }
+        th.col { writing-mode: vertical-rl; transform: rotate(180deg); white-space: nowrap; }
+        td.self { background: #eceff1; }
+        td.dep { background: #c8e6c9; }
+        td.cycle { background: #ef9a9a; color: #b71c1c; font-weight: bold; }
+    </style>
+</head>
+<body>
+    <h1>🔗 Dependency Matrix</h1>
+    <p>Cell [row][column] is marked when the row package imports the column package. <span style="color:#b71c1c">Red cells are mutual imports (cycles).</span></p>
+`)
+	if len(packages) == 0 {
+		b.WriteString("    <p>No packages found.</p>\n")
+	} else {
+		b.WriteString("    <table>\n        <tr><th></th>")
+		for _, pkg := range packages {
+			b.WriteString(fmt.Sprintf("<th class=\"col\">%s</th>", html.EscapeString(pkg)))
+		}
+		b.WriteString("</tr>\n")
+		cycles := 0
+		for _, from := range packages {
+			b.WriteString(fmt.Sprintf("        <tr><th class=\"row\">%s</th>", html.EscapeString(from)))
+			for _, to := range packages {
+				switch {
+				case from == to:
+					b.WriteString("<td class=\"self\"></td>")
+				case imports[from][to] && imports[to][from]:
+					b.WriteString(fmt.Sprintf("<td class=\"cycle\" title=\"%s ⇄ %s\">●</td>", html.EscapeString(from), html.EscapeString(to)))
+					cycles++
+				case imports[from][to]:
+					b.WriteString(fmt.Sprintf("<td class=\"dep\" title=\"%s → %s\">●</td>", html.EscapeString(from), html.EscapeString(to)))
+				default:
+					b.WriteString("<td></td>")
+				}
+			}
+			b.WriteString("</tr>\n")
+		}
+		b.WriteString("    </table>\n")
+		// Each mutual pair is drawn twice, once on each side of the diagonal
+		b.WriteString(fmt.Sprintf("    <p>%d packages, %d mutual imports.</p>\n", len(packages), cycles/2))
+	}
+	b.WriteString("</body>\n</html>\n")
+
+	outPath := filepath.Join(outDir, "Existing_dependency_matrix.html")
+	return os.WriteFile(outPath, []byte(b.String()), 0644)
+}
+
+// Existing_PrintImportEdges scans root and prints one "pkgA -> pkgB" line per import inside the module
+func Existing_PrintImportEdges(w io.Writer, root string) error {
+	structure, err := Existing_scanProject(root)
+	if err != nil {
+		return fmt.Errorf("scan: %w", err)
+	}
+	packages, imports, err := Existing_packageImportEdges(structure)
+	if err != nil {
+		return fmt.Errorf("imports: %w", err)
+	}
+	for _, from := range packages {
+		targets := make([]string, 0, len(imports[from]))
+		for to := range imports[from] {
+			targets = append(targets, to)
+		}
+		sort.Strings(targets)
+		for _, to := range targets {
+			fmt.Fprintf(w, "%s -> %s\n", from, to)
+		}
+	}
+	return nil
+}
+
+// Existing_packageImportEdges returns the package directories of the scanned files (relative to
+// the module root, sorted) and which of them import which, keeping only imports inside the module
+func Existing_packageImportEdges(structure *ProjectStructure) ([]string, map[string]map[string]bool, error) {
+	modRoot, _ := findModuleRoot(structure.Root)
+	modPath := readModulePath(filepath.Join(modRoot, "go.mod"))
+
+	imports := make(map[string]map[string]bool)
+	for _, file := range structure.Files {
+		abs := filepath.Join(structure.Root, filepath.FromSlash(file))
+		from := path.Dir(Existing_relSlash(modRoot, abs))
+		if imports[from] == nil {
+			imports[from] = make(map[string]bool)
+		}
+		if modPath == "" {
+			continue
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), abs, nil, parser.ImportsOnly)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, imp := range node.Imports {
+			importPath, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				continue
+			}
+			var to string
+			switch {
+			case importPath == modPath:
+				to = "."
+			case strings.HasPrefix(importPath, modPath+"/"):
+				to = strings.TrimPrefix(importPath, modPath+"/")
+			default:
+				continue
+			}
+			if to != from {
+				imports[from][to] = true
+			}
+		}
+	}
+
+	// Imported packages outside the scanned files still get a row and column
+	for _, targets := range imports {
+		for to := range targets {
+			if imports[to] == nil {
+				imports[to] = make(map[string]bool)
+			}
+		}
+	}
+	packages := make([]string, 0, len(imports))
+	for pkg := range imports {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+	return packages, imports, nil
+}
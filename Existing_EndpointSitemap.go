@@ -0,0 +1,132 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING ENDPOINT SITEMAP - THE API AT A GLANCE
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: A readable overview of the HTTP API, not a spec: the routes found
+             in code (the same parser as the data flow diagram) are grouped by
+             resource - the first path segment after any /api and /v1 style
+             prefixes - and each path lists the methods it answers. So
+             /workouts and /workouts/{id} sit together under "workouts".
+
+TO USE THIS FILE:
+1. Runs automatically as the "endpoint-sitemap" registered generator
+2. Or call Existing_WriteEndpointSitemap(outDir, root) directly
+3. Read Existing_endpoint_sitemap.md - a Markdown list and a Mermaid tree
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// versionSegment matches API version path segments such as v1 or v2
+var versionSegment = regexp.MustCompile(`^v[0-9]+$`)
+
+// endpointSitemapGenerator runs Existing_WriteEndpointSitemap from the generator registry
+type endpointSitemapGenerator struct{}
+
+func init() { Register(endpointSitemapGenerator{}) }
+
+func (endpointSitemapGenerator) Name() string { return "endpoint-sitemap" }
+
+func (endpointSitemapGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	return Existing_WriteEndpointSitemap(cfg.OutDir, cfg.Root)
+}
+
+// Existing_WriteEndpointSitemap groups the routes under root by resource and lists the methods per path
+func Existing_WriteEndpointSitemap(outDir, root string) error {
+	routes, err := Existing_findRoutes(root)
+	if err != nil {
+		return fmt.Errorf("find routes: %w", err)
+	}
+
+	// resource -> path -> methods
+	sitemap := make(map[string]map[string][]string)
+	for _, route := range routes {
+		resource := Existing_routeResource(route.Pattern)
+		if sitemap[resource] == nil {
+			sitemap[resource] = make(map[string][]string)
+		}
+		if methods := sitemap[resource][route.Pattern]; !slices.Contains(methods, route.Method) {
+			sitemap[resource][route.Pattern] = append(methods, route.Method)
+		}
+	}
+	resources := make([]string, 0, len(sitemap))
+	for resource := range sitemap {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	var b strings.Builder
+	b.WriteString("# Existing Endpoint Sitemap - Auto-Generated\n\n")
+	b.WriteString(fmt.Sprintf("%d routes on %d resources, grouped by the first path segment after /api and version prefixes. A readable overview, not an API spec.\n\n", len(routes), len(resources)))
+	if len(routes) == 0 {
+		b.WriteString("No route registrations found.\n")
+	}
+
+	var tree strings.Builder
+	tree.WriteString("```mermaid\n")
+	tree.WriteString("flowchart LR\n")
+	tree.WriteString("    API((\"🌐 API\"))\n")
+	for i, resource := range resources {
+		paths := make([]string, 0, len(sitemap[resource]))
+		for p := range sitemap[resource] {
+			paths = append(paths, p)
+		}
+		sort.Strings(paths)
+
+		b.WriteString(fmt.Sprintf("## 📁 %s\n\n", resource))
+		resID := fmt.Sprintf("R%d", i+1)
+		tree.WriteString(fmt.Sprintf("    API --> %s[\"📁 %s\"]\n", resID, Existing_mermaidLabel(resource)))
+		for j, p := range paths {
+			methods := sitemap[resource][p]
+			sort.Strings(methods)
+			b.WriteString(fmt.Sprintf("- `%s` - %s\n", p, "**"+strings.Join(methods, "**, **")+"**"))
+			tree.WriteString(fmt.Sprintf("    %s --> %s_%d[\"%s<br/>%s\"]\n", resID, resID, j+1, Existing_mermaidLabel(p), strings.Join(methods, " · ")))
+		}
+		b.WriteString("\n")
+	}
+	tree.WriteString("```\n")
+
+	if len(routes) > 0 {
+		b.WriteString("## 🌳 Tree\n\n")
+		b.WriteString(tree.String())
+	}
+
+	path := filepath.Join(outDir, "Existing_endpoint_sitemap.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_routeResource returns the resource a route belongs to: its first path segment
+// after any "api" and version (v1, v2, ...) prefixes, or "/" for the root
+func Existing_routeResource(pattern string) string {
+	for _, segment := range strings.Split(strings.Trim(pattern, "/"), "/") {
+		if segment == "" || segment == "api" || versionSegment.MatchString(segment) {
+			continue
+		}
+		return segment
+	}
+	return "/"
+}
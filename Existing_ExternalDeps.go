@@ -0,0 +1,225 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING EXTERNAL DEPENDENCIES - THIRD-PARTY MODULES AND WHO USES THEM
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file reads the require lines of go.mod and cross-references
+             them with the imports of every Go file in the project, so each
+             third-party module lists the files that pull it in. Modules marked
+             "// indirect" in go.mod are reported separately from the direct
+             ones; a direct module no file imports is flagged as unused.
+
+TO USE THIS FILE:
+1. Runs automatically as the "external-deps" registered generator
+2. Or call Existing_WriteExternalDepsReport(outDir, root) directly
+
+FEATURES:
+- Existing_external_deps.md - Per-module importers, direct vs indirect
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// goModRequire is one require entry of go.mod
+type goModRequire struct {
+	Path     string
+	Version  string
+	Indirect bool
+}
+
+// externalDepsGenerator runs Existing_WriteExternalDepsReport from the generator registry
+type externalDepsGenerator struct{}
+
+func init() { Register(externalDepsGenerator{}) }
+
+func (externalDepsGenerator) Name() string { return "external-deps" }
+
+func (externalDepsGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	return Existing_WriteExternalDepsReport(cfg.OutDir, cfg.Root)
+}
+
+// Existing_WriteExternalDepsReport lists the go.mod requirements and the project files importing each
+func Existing_WriteExternalDepsReport(outDir, root string) error {
+	goMod := filepath.Join(root, "go.mod")
+	if !fileExists(goMod) {
+		fmt.Println("ℹ️  External dependencies: no go.mod in project root")
+		return nil
+	}
+	requires, err := Existing_parseGoModRequires(goMod)
+	if err != nil {
+		return fmt.Errorf("read go.mod: %w", err)
+	}
+	importers, err := Existing_moduleImporters(root, requires)
+	if err != nil {
+		return fmt.Errorf("collect imports: %w", err)
+	}
+
+	var direct, indirect []goModRequire
+	for _, req := range requires {
+		if req.Indirect {
+			indirect = append(indirect, req)
+		} else {
+			direct = append(direct, req)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# Existing External Dependencies - Auto-Generated\n\n")
+	b.WriteString("Third-party modules required by `go.mod` and the project files that import them.\n\n")
+	if len(requires) == 0 {
+		b.WriteString("_go.mod has no requirements - the project only uses the standard library._\n")
+	}
+
+	section := func(title string, reqs []goModRequire) {
+		if len(reqs) == 0 {
+			return
+		}
+		b.WriteString(fmt.Sprintf("## %s (%d)\n\n", title, len(reqs)))
+		b.WriteString("| Module | Version | Imported By |\n")
+		b.WriteString("|--------|---------|-------------|\n")
+		for _, req := range reqs {
+			files := importers[req.Path]
+			usedBy := "_not imported by project files_"
+			if len(files) > 0 {
+				quoted := make([]string, len(files))
+				for i, file := range files {
+					quoted[i] = "`" + file + "`"
+				}
+				usedBy = strings.Join(quoted, ", ")
+			} else if !req.Indirect {
+				usedBy = "⚠️ not imported - try `go mod tidy`"
+			}
+			b.WriteString(fmt.Sprintf("| **%s** | %s | %s |\n", req.Path, req.Version, usedBy))
+		}
+		b.WriteString("\n")
+	}
+	section("Direct Dependencies", direct)
+	section("Indirect Dependencies", indirect)
+
+	b.WriteString("## Summary\n\n")
+	b.WriteString(fmt.Sprintf("- **Direct:** %d\n", len(direct)))
+	b.WriteString(fmt.Sprintf("- **Indirect:** %d\n", len(indirect)))
+
+	path := filepath.Join(outDir, "Existing_external_deps.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_parseGoModRequires reads single-line and block require entries, noting "// indirect"
+func Existing_parseGoModRequires(goMod string) ([]goModRequire, error) {
+	f, err := os.Open(goMod)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var requires []goModRequire
+	inBlock := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		comment := ""
+		if i := strings.Index(line, "//"); i >= 0 {
+			line, comment = strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+2:])
+		}
+		switch {
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case line == "require (":
+			inBlock = true
+			continue
+		case strings.HasPrefix(line, "require "):
+			line = strings.TrimSpace(strings.TrimPrefix(line, "require "))
+		case !inBlock:
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		requires = append(requires, goModRequire{
+			Path:     strings.Trim(fields[0], `"`),
+			Version:  fields[1],
+			Indirect: comment == "indirect" || strings.HasPrefix(comment, "indirect;"),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(requires, func(i, j int) bool { return requires[i].Path < requires[j].Path })
+	return requires, nil
+}
+
+// Existing_moduleImporters maps each required module to the project files importing one of its packages
+func Existing_moduleImporters(root string, requires []goModRequire) (map[string][]string, error) {
+	importers := make(map[string][]string)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+		if err != nil {
+			return nil // unparsable files do not block the report
+		}
+		file := Existing_relSlash(root, path)
+		seen := make(map[string]bool)
+		for _, imp := range node.Imports {
+			importPath, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				continue
+			}
+			module := Existing_owningModule(requires, importPath)
+			if module != "" && !seen[module] {
+				seen[module] = true
+				importers[module] = append(importers[module], file)
+			}
+		}
+		return nil
+	})
+	return importers, err
+}
+
+// Existing_owningModule returns the longest required module path that contains importPath
+func Existing_owningModule(requires []goModRequire, importPath string) string {
+	best := ""
+	for _, req := range requires {
+		if (importPath == req.Path || strings.HasPrefix(importPath, req.Path+"/")) && len(req.Path) > len(best) {
+			best = req.Path
+		}
+	}
+	return best
+}
@@ -0,0 +1,122 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING HANDLER DEPENDENCIES - THE REQUEST PATH ONLY
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The full function dependency diagram shows everything; for an
+             API review only the request path matters. This diagram keeps the
+             HTTP handlers - found by signature, (http.ResponseWriter,
+             *http.Request) - and the store-layer methods they reach through
+             the call graph (-typed for go/types edges, name matching
+             otherwise). Helper functions in between are followed but not
+             drawn, so each handler points straight at its store methods;
+             store methods calling each other keep their edge.
+
+TO USE THIS FILE:
+1. go run -tags flowcharts . -handlers-only
+2. Or interactive option 8, next to the simplified and full diagrams
+3. Read Existing_function_dependencies_handlers.mmd.md
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Existing_isHandlerSignature reports whether fn has the net/http handler signature
+func Existing_isHandlerSignature(fn FunctionInfo) bool {
+	return strings.Contains(fn.Signature, "http.ResponseWriter") && strings.Contains(fn.Signature, "*http.Request")
+}
+
+// Existing_WriteHandlerDependencyDiagram draws the handlers and the store methods they reach, hiding everything else
+func Existing_WriteHandlerDependencyDiagram(outDir string, structure *ProjectStructure) error {
+	edges, err := Existing_callEdges(structure)
+	if err != nil {
+		return fmt.Errorf("call graph: %w", err)
+	}
+
+	var handlers []FunctionInfo
+	byName := make(map[string]FunctionInfo)
+	isStore := make(map[string]bool)
+	for _, fn := range Existing_ownFunctions(structure) {
+		byName[fn.Name] = fn
+		if Existing_isHandlerSignature(fn) {
+			handlers = append(handlers, fn)
+		} else if Existing_pathHasDir(fn.File, "store", "repository", "repo") {
+			isStore[fn.Name] = true
+		}
+	}
+	sort.Slice(handlers, func(i, j int) bool { return handlers[i].Name < handlers[j].Name })
+
+	used := make(map[string]bool)
+	ids := make(map[string]string)
+	var nodes, links strings.Builder
+	node := func(name, class string) string {
+		if id, ok := ids[name]; ok {
+			return id
+		}
+		id := Existing_uniqueMermaidID(used, name)
+		ids[name] = id
+		fn := byName[name]
+		label := Existing_diagramName(fn)
+		file := "📁 " + filepath.Base(fn.File)
+		nodes.WriteString(fmt.Sprintf("    %s[\"%s\"]:::%s\n", id, Existing_nodeLabel(label, file, label+"<br/>"+file), class))
+		return id
+	}
+
+	// Handler -> every store method it reaches, then the calls among those store methods
+	var stores []string
+	for _, h := range handlers {
+		handlerID := node(h.Name, "apiClass")
+		for _, store := range Existing_reachableStores(h.Name, edges, isStore) {
+			links.WriteString(fmt.Sprintf("    %s --> %s\n", handlerID, node(store, "storeClass")))
+			if !slices.Contains(stores, store) {
+				stores = append(stores, store)
+			}
+		}
+	}
+	sort.Strings(stores)
+	for _, from := range stores {
+		for _, to := range edges[from] {
+			if to != from && slices.Contains(stores, to) {
+				links.WriteString(fmt.Sprintf("    %s --> %s\n", ids[from], ids[to]))
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# Existing Handler Dependencies - Auto-Generated\n\n")
+	b.WriteString(fmt.Sprintf("%d handlers and the %d store methods they reach; helper functions in between are hidden.\n\n", len(handlers), len(stores)))
+	b.WriteString("```mermaid\n")
+	b.WriteString("flowchart LR\n")
+	b.WriteString("    classDef apiClass fill:#fce4ec,stroke:#c2185b,stroke-width:3px,color:#000,font-size:14px,font-weight:bold\n")
+	b.WriteString("    classDef storeClass fill:#f3e5f5,stroke:#7b1fa2,stroke-width:3px,color:#000,font-size:14px,font-weight:bold\n")
+	if len(handlers) == 0 {
+		b.WriteString("    NoHandlers[\"No func(http.ResponseWriter, *http.Request) handlers found\"]\n")
+	}
+	b.WriteString(nodes.String())
+	b.WriteString(links.String())
+	b.WriteString("```\n")
+
+	path := filepath.Join(outDir, "Existing_function_dependencies_handlers.mmd.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
@@ -0,0 +1,147 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING IMPORT CYCLES - CATCH CYCLES BEFORE GO BUILD DOES
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: Go forbids import cycles, but in the middle of a refactor the
+             "import cycle not allowed" error only names one path. This file
+             runs Tarjan's strongly-connected-components algorithm over the
+             package import edges (the same edges as the dependency matrix)
+             and reports every component with more than one package as a
+             cycle, together with the imports that close it.
+
+TO USE THIS FILE:
+1. Runs with the other dynamic reports after the project scan
+2. Read Existing_import_cycles.md - "No import cycles" means the graph is a DAG
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Existing_WriteImportCyclesReport lists the package import cycles found with Tarjan's SCC
+func Existing_WriteImportCyclesReport(outDir string, structure *ProjectStructure) error {
+	packages, imports, err := Existing_packageImportEdges(structure)
+	if err != nil {
+		return fmt.Errorf("collect package imports: %w", err)
+	}
+
+	var cycles [][]string
+	for _, component := range Existing_stronglyConnected(packages, imports) {
+		if len(component) > 1 {
+			cycles = append(cycles, component)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# Existing Import Cycles - Auto-Generated\n\n")
+	b.WriteString(fmt.Sprintf("Strongly-connected components of the import graph of %d packages. Any component with more than one package is an import cycle.\n\n", len(packages)))
+	if len(cycles) == 0 {
+		b.WriteString("✅ **No import cycles.**\n")
+	}
+	for i, cycle := range cycles {
+		b.WriteString(fmt.Sprintf("## ❌ Cycle %d (%d packages)\n\n", i+1, len(cycle)))
+		b.WriteString("**Packages:** `" + strings.Join(cycle, "`, `") + "`\n\n")
+		b.WriteString("**Imports inside the cycle:**\n\n")
+		inCycle := make(map[string]bool, len(cycle))
+		for _, pkg := range cycle {
+			inCycle[pkg] = true
+		}
+		for _, from := range cycle {
+			targets := make([]string, 0, len(imports[from]))
+			for to := range imports[from] {
+				if inCycle[to] {
+					targets = append(targets, to)
+				}
+			}
+			sort.Strings(targets)
+			for _, to := range targets {
+				b.WriteString(fmt.Sprintf("- `%s` → `%s`\n", from, to))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	if len(cycles) > 0 {
+		fmt.Printf("⚠️  %d import cycle(s) found - see Existing_import_cycles.md\n", len(cycles))
+	}
+	path := filepath.Join(outDir, "Existing_import_cycles.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_stronglyConnected returns the strongly-connected components of the import graph
+// (Tarjan's algorithm), each sorted, in order of their first package
+func Existing_stronglyConnected(packages []string, imports map[string]map[string]bool) [][]string {
+	index := make(map[string]int)
+	lowlink := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+	next := 0
+
+	var visit func(pkg string)
+	visit = func(pkg string) {
+		index[pkg] = next
+		lowlink[pkg] = next
+		next++
+		stack = append(stack, pkg)
+		onStack[pkg] = true
+
+		targets := make([]string, 0, len(imports[pkg]))
+		for to := range imports[pkg] {
+			targets = append(targets, to)
+		}
+		sort.Strings(targets)
+		for _, to := range targets {
+			if _, seen := index[to]; !seen {
+				visit(to)
+				lowlink[pkg] = min(lowlink[pkg], lowlink[to])
+			} else if onStack[to] {
+				lowlink[pkg] = min(lowlink[pkg], index[to])
+			}
+		}
+
+		// pkg is the root of a component: pop it off the stack
+		if lowlink[pkg] == index[pkg] {
+			var component []string
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component = append(component, top)
+				if top == pkg {
+					break
+				}
+			}
+			sort.Strings(component)
+			components = append(components, component)
+		}
+	}
+
+	for _, pkg := range packages {
+		if _, seen := index[pkg]; !seen {
+			visit(pkg)
+		}
+	}
+	sort.Slice(components, func(i, j int) bool { return components[i][0] < components[j][0] })
+	return components
+}
@@ -0,0 +1,106 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING INIT FUNCTIONS - WHAT RUNS BEFORE MAIN
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: func init() runs at import time, before main(), in an order Go
+             defines: a package's imports are initialised before the package
+             itself, and within a package the files run in file name order.
+             The scanner flags these functions (FunctionInfo.Init) and this
+             file orders them the same way from the module's own import graph,
+             so the execution flow and dependency diagrams can draw them as
+             one "Initialization (import-time)" group instead of mixing them
+             in with the callable functions. Imports outside the module are
+             not followed; packages that do not import each other keep their
+             directory order.
+
+TO USE THIS FILE:
+1. Existing_initFunctions(structure, functions) returns the init functions in run order
+2. Used by AIAd_WriteExecutionFlowDiagram and Existing_WriteFunctionDependencyDiagram
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+// Existing_initFunctions returns the init functions among functions in the order Go runs them:
+// imported packages first (by the module's import graph), then file name, then source line
+func Existing_initFunctions(structure *ProjectStructure, functions []FunctionInfo) []FunctionInfo {
+	var inits []FunctionInfo
+	for _, fn := range functions {
+		if fn.Init {
+			inits = append(inits, fn)
+		}
+	}
+	if len(inits) == 0 {
+		return nil
+	}
+
+	rank := Existing_packageInitRank(structure)
+	modRoot, _ := findModuleRoot(structure.Root)
+	pkgDir := func(fn FunctionInfo) string {
+		return path.Dir(Existing_relSlash(modRoot, filepath.Join(structure.Root, filepath.FromSlash(fn.File))))
+	}
+	sort.SliceStable(inits, func(i, j int) bool {
+		pi, pj := pkgDir(inits[i]), pkgDir(inits[j])
+		if pi != pj {
+			if rank[pi] != rank[pj] {
+				return rank[pi] < rank[pj]
+			}
+			return pi < pj
+		}
+		if inits[i].File != inits[j].File {
+			return path.Base(inits[i].File) < path.Base(inits[j].File)
+		}
+		return inits[i].Line < inits[j].Line
+	})
+	return inits
+}
+
+// Existing_packageInitRank numbers the module's package directories in initialisation order:
+// a depth-first walk that ranks every package after the packages it imports
+func Existing_packageInitRank(structure *ProjectStructure) map[string]int {
+	rank := make(map[string]int)
+	packages, imports, err := Existing_packageImportEdges(structure)
+	if err != nil {
+		return rank // unparsable imports: fall back to directory order
+	}
+	visiting := make(map[string]bool)
+	var visit func(pkg string)
+	visit = func(pkg string) {
+		if _, done := rank[pkg]; done || visiting[pkg] {
+			return // already ranked, or an import cycle (which go build rejects anyway)
+		}
+		visiting[pkg] = true
+		deps := make([]string, 0, len(imports[pkg]))
+		for dep := range imports[pkg] {
+			deps = append(deps, dep)
+		}
+		sort.Strings(deps)
+		for _, dep := range deps {
+			visit(dep)
+		}
+		rank[pkg] = len(rank)
+	}
+	for _, pkg := range packages {
+		visit(pkg)
+	}
+	return rank
+}
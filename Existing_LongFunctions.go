@@ -0,0 +1,93 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING LONG FUNCTIONS - A MAINTAINABILITY WARNING LIST
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The scan records how many lines each function declaration spans
+             (FunctionInfo.Lines, signature to closing brace). Functions longer
+             than -max-func-lines (default 80) are listed longest first with
+             file:line, as candidates for splitting up.
+
+TO USE THIS FILE:
+1. Runs with the other dynamic reports after the project scan
+2. go run -tags flowcharts . -max-func-lines 50 for a stricter limit
+3. Read Existing_long_functions.md
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// defaultMaxFuncLines is the -max-func-lines default
+const defaultMaxFuncLines = 80
+
+// maxFuncLines is the length above which a function is reported as long (set from -max-func-lines)
+var maxFuncLines = defaultMaxFuncLines
+
+// setMaxFuncLines validates and sets the -max-func-lines value
+func setMaxFuncLines(n int) error {
+	if n < 1 {
+		return fmt.Errorf("%d is not a positive number of lines", n)
+	}
+	maxFuncLines = n
+	return nil
+}
+
+// Existing_WriteLongFunctionsReport lists the functions longer than maxFuncLines, longest first
+func Existing_WriteLongFunctionsReport(outDir string, structure *ProjectStructure) error {
+	var long []FunctionInfo
+	for _, fn := range Existing_ownFunctions(structure) {
+		if fn.Lines > maxFuncLines {
+			long = append(long, fn)
+		}
+	}
+	sort.SliceStable(long, func(i, j int) bool {
+		if long[i].Lines != long[j].Lines {
+			return long[i].Lines > long[j].Lines
+		}
+		if long[i].File != long[j].File {
+			return long[i].File < long[j].File
+		}
+		return long[i].Line < long[j].Line
+	})
+
+	var b strings.Builder
+	b.WriteString("# Existing Long Functions - Auto-Generated\n\n")
+	b.WriteString(fmt.Sprintf("Functions longer than %d lines (signature to closing brace; change with `-max-func-lines`).\n\n", maxFuncLines))
+	if len(long) == 0 {
+		b.WriteString(fmt.Sprintf("✅ No function is longer than %d lines.\n", maxFuncLines))
+	} else {
+		b.WriteString(fmt.Sprintf("**⚠️ Long functions:** %d\n\n", len(long)))
+		b.WriteString("| Lines | Function | Location |\n")
+		b.WriteString("|-------|----------|----------|\n")
+		for _, fn := range long {
+			name := fn.Name
+			if fn.Receiver != "" {
+				name = fn.Receiver + "." + fn.Name
+			}
+			b.WriteString(fmt.Sprintf("| %d | `%s` | `%s:%d` |\n", fn.Lines, name, fn.File, fn.Line))
+		}
+	}
+
+	path := filepath.Join(outDir, "Existing_long_functions.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
@@ -0,0 +1,249 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING MERGE REPORTS - ORG-WIDE VIEW OF SEVERAL SERVICES
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: Every run saves the scanned project structure as
+             Existing_structure.json. This file loads several of those JSONs
+             (one per service) and combines them into a single inventory and
+             a top-level Mermaid overview of package counts per service.
+             Package names used by more than one service are namespaced with
+             the service's module path so they do not collapse together.
+
+TO USE THIS FILE:
+1. Run the tool once per service to produce Existing_structure.json
+2. Run with -task merge -inputs a.json,b.json,c.json
+3. Reports are saved in the -out directory
+
+FEATURES:
+- Existing_structure.json - Scanned structure of one run (input for merge)
+- Existing_merged_inventory.md - Combined function inventory
+- Existing_merged_overview.mmd.md - Cross-service package overview
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// StructureSnapshot is the JSON form of one scanned project
+type StructureSnapshot struct {
+	Module    string            `json:"module"`
+	Root      string            `json:"root"`
+	Structure *ProjectStructure `json:"structure"`
+}
+
+// mergedPackage is one package of one service in the combined view
+type mergedPackage struct {
+	Service   string
+	Name      string // namespaced with the module path when the name is shared
+	Files     int
+	Functions []FunctionInfo
+}
+
+// Existing_WriteStructureJSON saves the scanned structure so later runs can merge it
+func Existing_WriteStructureJSON(outDir, root string, structure *ProjectStructure) error {
+	snapshot := StructureSnapshot{
+		Module:    readModulePath(filepath.Join(root, "go.mod")),
+		Root:      root,
+		Structure: structure,
+	}
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal structure: %w", err)
+	}
+	path := filepath.Join(outDir, "Existing_structure.json")
+	return os.WriteFile(path, data, 0644)
+}
+
+// Existing_loadStructureJSON reads one Existing_structure.json file
+func Existing_loadStructureJSON(path string) (*StructureSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var snapshot StructureSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if snapshot.Structure == nil {
+		return nil, fmt.Errorf("%s: no structure found", path)
+	}
+	// Fall back to the project directory when the run had no go.mod; every
+	// input is called Existing_structure.json, so the file name says nothing
+	if snapshot.Module == "" {
+		dir := snapshot.Root
+		if dir == "" {
+			dir = filepath.Dir(path)
+		}
+		if abs, err := filepath.Abs(dir); err == nil {
+			dir = abs
+		}
+		snapshot.Module = filepath.Base(dir)
+	}
+	return &snapshot, nil
+}
+
+// Existing_MergeStructures loads several structure JSONs and writes a combined inventory and overview
+func Existing_MergeStructures(inputs []string, outDir string) error {
+	if len(inputs) < 2 {
+		return fmt.Errorf("merge needs at least two inputs, got %d", len(inputs))
+	}
+
+	var snapshots []*StructureSnapshot
+	used := make(map[string]bool)
+	for _, input := range inputs {
+		snapshot, err := Existing_loadStructureJSON(input)
+		if err != nil {
+			return fmt.Errorf("load %s: %w", input, err)
+		}
+		// Two inputs of the same module (or unnamed projects) stay separate services
+		for base, n := snapshot.Module, 2; used[snapshot.Module]; n++ {
+			snapshot.Module = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[snapshot.Module] = true
+		snapshots = append(snapshots, snapshot)
+		fmt.Printf("📥 Loaded %s (%s): %d functions\n", input, snapshot.Module, len(snapshot.Structure.Functions))
+	}
+
+	// Count how many services use each package name
+	owners := make(map[string]map[string]bool)
+	for _, s := range snapshots {
+		for pkg := range s.Structure.Packages {
+			if owners[pkg] == nil {
+				owners[pkg] = make(map[string]bool)
+			}
+			owners[pkg][s.Module] = true
+		}
+	}
+
+	var packages []mergedPackage
+	for _, s := range snapshots {
+		byPkg := make(map[string][]FunctionInfo)
+		for _, fn := range s.Structure.Functions {
+			byPkg[fn.Package] = append(byPkg[fn.Package], fn)
+		}
+		for pkg, files := range s.Structure.Packages {
+			name := pkg
+			if len(owners[pkg]) > 1 {
+				name = s.Module + "/" + pkg
+			}
+			packages = append(packages, mergedPackage{
+				Service:   s.Module,
+				Name:      name,
+				Files:     len(files),
+				Functions: byPkg[pkg],
+			})
+		}
+	}
+	sort.Slice(packages, func(i, j int) bool {
+		if packages[i].Service != packages[j].Service {
+			return packages[i].Service < packages[j].Service
+		}
+		return packages[i].Name < packages[j].Name
+	})
+
+	if err := ensureDir(outDir); err != nil {
+		return err
+	}
+	if err := Existing_writeMergedInventory(outDir, snapshots, packages); err != nil {
+		return err
+	}
+	if err := Existing_writeMergedOverview(outDir, packages); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Merged %d services into %s\n", len(snapshots), outDir)
+	return nil
+}
+
+// Existing_writeMergedInventory writes the combined function inventory
+func Existing_writeMergedInventory(outDir string, snapshots []*StructureSnapshot, packages []mergedPackage) error {
+	var content strings.Builder
+	content.WriteString("# Existing Merged Function Inventory - Auto-Generated\n\n")
+	content.WriteString("This document combines the function inventories of several services.\n")
+	content.WriteString("Package names shared by more than one service are prefixed with the module path.\n\n")
+
+	totalFunctions, totalFiles := 0, 0
+	service := ""
+	for _, pkg := range packages {
+		if pkg.Service != service {
+			service = pkg.Service
+			content.WriteString(fmt.Sprintf("## Service: %s\n\n", service))
+		}
+		content.WriteString(fmt.Sprintf("### %s: %s\n\n", tr("report.inventory.package"), pkg.Name))
+		content.WriteString(fmt.Sprintf("**%s:** %d  |  **%s:** %d\n\n", tr("report.inventory.files"), pkg.Files, tr("report.inventory.functions"), len(pkg.Functions)))
+		for _, fn := range pkg.Functions {
+			content.WriteString(fmt.Sprintf("- **%s**", fn.Name))
+			if fn.IsMethod {
+				content.WriteString(fmt.Sprintf(" (%s %s)", tr("report.inventory.methodOn"), fn.Receiver))
+			}
+			content.WriteString(fmt.Sprintf(" - `%s:%d`\n", fn.File, fn.Line))
+		}
+		content.WriteString("\n")
+		totalFunctions += len(pkg.Functions)
+		totalFiles += pkg.Files
+	}
+
+	content.WriteString(fmt.Sprintf("## %s\n\n", tr("report.summary")))
+	content.WriteString(fmt.Sprintf("- **Services:** %d\n", len(snapshots)))
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalPackages"), len(packages)))
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalFiles"), totalFiles))
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalFunctions"), totalFunctions))
+
+	path := filepath.Join(outDir, "Existing_merged_inventory.md")
+	return os.WriteFile(path, []byte(content.String()), 0644)
+}
+
+// Existing_writeMergedOverview writes a Mermaid overview with one subgraph per service
+func Existing_writeMergedOverview(outDir string, packages []mergedPackage) error {
+	var content strings.Builder
+	content.WriteString("# Existing Merged Services Overview - Auto-Generated\n\n")
+	content.WriteString("```mermaid\nflowchart TD\n")
+
+	pkgCount := make(map[string]int)
+	for _, pkg := range packages {
+		pkgCount[pkg.Service]++
+	}
+
+	service := ""
+	serviceNum := 0
+	for i, pkg := range packages {
+		if pkg.Service != service {
+			if service != "" {
+				content.WriteString("    end\n")
+			}
+			service = pkg.Service
+			serviceNum++
+			content.WriteString(fmt.Sprintf("    subgraph SVC%d[\"🧩 %s (%d packages)\"]\n", serviceNum, service, pkgCount[service]))
+		}
+		content.WriteString(fmt.Sprintf("        PKG%d[\"📦 %s<br/>%d %s | %d %s\"]\n",
+			i+1, pkg.Name, pkg.Files, tr("unit.files"), len(pkg.Functions), tr("unit.functions")))
+	}
+	if service != "" {
+		content.WriteString("    end\n")
+	}
+	content.WriteString("```\n")
+
+	path := filepath.Join(outDir, "Existing_merged_overview.mmd.md")
+	return os.WriteFile(path, []byte(content.String()), 0644)
+}
@@ -0,0 +1,237 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING MIDDLEWARE CHAIN - REAL r.Use(...) ORDER FROM routes.go
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The AI advisor diagrams assume a fixed CORS -> Auth -> ownership
+             middleware order. This file reads the r.Use(...) calls in every
+             routes.go of the project and draws the order they really run in.
+             Middleware added inside r.Group(...) and r.Route(...) closures is
+             drawn as its own sub-chain that continues from the parent chain.
+
+TO USE THIS FILE:
+1. Runs automatically as the "middleware" registered generator
+2. Or call Existing_WriteMiddlewareChainDiagram(root, outDir) directly
+3. Nothing is written when the project has no routes.go with r.Use calls
+
+FEATURES:
+- Existing_middleware_chain.mmd.md - Linear middleware flow per route setup
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// middlewareChain is the ordered middleware of one router scope
+type middlewareChain struct {
+	Label      string
+	File       string
+	Parent     int // index of the enclosing chain, -1 for a route setup function
+	Middleware []middlewareUse
+}
+
+// middlewareUse is one middleware passed to Use
+type middlewareUse struct {
+	Name string
+	Line int
+}
+
+// middlewareGenerator runs Existing_WriteMiddlewareChainDiagram from the generator registry
+type middlewareGenerator struct{}
+
+func init() { Register(middlewareGenerator{}) }
+
+func (middlewareGenerator) Name() string { return "middleware" }
+
+func (middlewareGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	return Existing_WriteMiddlewareChainDiagram(cfg.Root, cfg.OutDir)
+}
+
+// Existing_WriteMiddlewareChainDiagram draws the middleware order found in the project's routes.go files
+func Existing_WriteMiddlewareChainDiagram(root, outDir string) error {
+	chains, err := Existing_findMiddlewareChains(root)
+	if err != nil {
+		return fmt.Errorf("scan middleware: %w", err)
+	}
+	total := 0
+	for _, chain := range chains {
+		total += len(chain.Middleware)
+	}
+	if total == 0 {
+		fmt.Println("ℹ️  Middleware chain: no r.Use(...) calls found in routes.go")
+		return nil
+	}
+
+	// tail[i] is the node the next middleware of chain i follows
+	tail := make([]string, len(chains))
+	var content strings.Builder
+	content.WriteString("# Existing Middleware Chain - Auto-Generated\n\n")
+	content.WriteString("Middleware in the order it is registered with `Use` in routes.go. Requests pass through it left to right before reaching the handlers. Sub-routers from `Group`/`Route` continue from their parent chain.\n\n")
+	content.WriteString("```mermaid\n")
+	content.WriteString("flowchart LR\n")
+
+	for i, chain := range chains {
+		if chain.Parent < 0 {
+			if !Existing_chainHasMiddleware(chains, i) {
+				continue
+			}
+			tail[i] = fmt.Sprintf("C%d_REQ", i)
+			content.WriteString(fmt.Sprintf("    %s([\"📥 Request<br/>%s<br/>📍 %s\"])\n", tail[i], chain.Label, chain.File))
+		} else {
+			tail[i] = tail[chain.Parent]
+		}
+		if len(chain.Middleware) == 0 {
+			continue
+		}
+
+		indent := "    "
+		if chain.Parent >= 0 {
+			content.WriteString(fmt.Sprintf("    subgraph C%d[\"🔀 %s\"]\n", i, chain.Label))
+			indent = "        "
+		}
+		for j, mw := range chain.Middleware {
+			content.WriteString(fmt.Sprintf("%sC%d_M%d[\"%d. %s<br/>📍 line %d\"]:::middleware\n", indent, i, j, j+1, mw.Name, mw.Line))
+		}
+		content.WriteString(fmt.Sprintf("%sC%d_H[[\"🎯 Handlers\"]]\n", indent, i))
+		if chain.Parent >= 0 {
+			content.WriteString("    end\n")
+		}
+
+		prev := tail[i]
+		for j := range chain.Middleware {
+			node := fmt.Sprintf("C%d_M%d", i, j)
+			content.WriteString(fmt.Sprintf("    %s --> %s\n", prev, node))
+			prev = node
+		}
+		content.WriteString(fmt.Sprintf("    %s --> C%d_H\n", prev, i))
+		tail[i] = prev
+	}
+	content.WriteString("    classDef middleware fill:#e3f2fd,stroke:#1565c0,stroke-width:2px\n")
+	content.WriteString("```\n\n")
+	content.WriteString(fmt.Sprintf("**Middleware registrations:** %d\n", total))
+
+	path := filepath.Join(outDir, "Existing_middleware_chain.mmd.md")
+	return os.WriteFile(path, []byte(content.String()), 0644)
+}
+
+// Existing_findMiddlewareChains parses every routes.go under root and collects its Use chains
+func Existing_findMiddlewareChains(root string) ([]middlewareChain, error) {
+	var chains []middlewareChain
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.Name() != "routes.go" {
+			return nil
+		}
+
+		fset := token.NewFileSet()
+		node, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			fmt.Printf("⚠️  Middleware chain: skipping %s: %v\n", path, err)
+			return nil
+		}
+		rel := Existing_relSlash(root, path)
+		for _, decl := range node.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			chains = append(chains, middlewareChain{Label: fn.Name.Name + "()", File: rel, Parent: -1})
+			Existing_collectMiddleware(fset, fn.Body, len(chains)-1, &chains)
+		}
+		return nil
+	})
+	return chains, err
+}
+
+// Existing_collectMiddleware records Use calls in body on chain idx and opens a
+// new chain for every Group/Route closure
+func Existing_collectMiddleware(fset *token.FileSet, body ast.Node, idx int, chains *[]middlewareChain) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		switch sel.Sel.Name {
+		case "Use":
+			for _, arg := range call.Args {
+				(*chains)[idx].Middleware = append((*chains)[idx].Middleware, middlewareUse{
+					Name: Existing_middlewareName(arg),
+					Line: fset.Position(arg.Pos()).Line,
+				})
+			}
+			return false
+		case "Group", "Route":
+			label := sel.Sel.Name
+			for _, arg := range call.Args {
+				if lit, ok := arg.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					label += " " + strings.Trim(lit.Value, "`\"")
+				}
+			}
+			for _, arg := range call.Args {
+				if lit, ok := arg.(*ast.FuncLit); ok {
+					*chains = append(*chains, middlewareChain{Label: label, File: (*chains)[idx].File, Parent: idx})
+					Existing_collectMiddleware(fset, lit.Body, len(*chains)-1, chains)
+				}
+			}
+			return false
+		}
+		return true
+	})
+}
+
+// Existing_middlewareName renders a Use argument, shortening configured middleware such as Timeout(60 * time.Second)
+func Existing_middlewareName(expr ast.Expr) string {
+	suffix := ""
+	if call, ok := expr.(*ast.CallExpr); ok {
+		expr, suffix = call.Fun, "(…)"
+	}
+	return strings.ReplaceAll(types.ExprString(expr), "\"", "'") + suffix
+}
+
+// Existing_chainHasMiddleware reports whether chain idx or any chain nested in it registers middleware
+func Existing_chainHasMiddleware(chains []middlewareChain, idx int) bool {
+	if len(chains[idx].Middleware) > 0 {
+		return true
+	}
+	for i := idx + 1; i < len(chains); i++ {
+		if chains[i].Parent == idx && Existing_chainHasMiddleware(chains, i) {
+			return true
+		}
+	}
+	return false
+}
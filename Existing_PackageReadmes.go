@@ -0,0 +1,126 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING PACKAGE READMES - DOCUMENTATION STUBS FROM THE SCAN
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: Writing package docs from a blank page is the part nobody
+             starts. This file writes one README stub per package under
+             readmes/: a header with the package's files and function count,
+             a placeholder overview to fill in, and the exported functions
+             with a one-line purpose - the doc comment when there is one,
+             otherwise the guess from the name, marked as inferred. Copy a
+             stub next to the package and edit it from there.
+
+TO USE THIS FILE:
+1. Runs with the other dynamic reports after the project scan
+2. Read readmes/<pkg>.md (readmes/index.md lists them)
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Existing_WritePackageReadmes writes readmes/<pkg>.md for every scanned package, plus readmes/index.md
+func Existing_WritePackageReadmes(outDir string, structure *ProjectStructure) error {
+	dir := filepath.Join(outDir, "readmes")
+	if err := ensureDir(dir); err != nil {
+		return err
+	}
+
+	byPkg := make(map[string][]FunctionInfo)
+	for _, fn := range Existing_ownFunctions(structure) {
+		byPkg[fn.Package] = append(byPkg[fn.Package], fn)
+	}
+
+	var index strings.Builder
+	index.WriteString("# Package README Stubs - Auto-Generated\n\n")
+	index.WriteString("One README stub per package, generated from the scan. Fill in the overview and copy it next to the package.\n\n")
+	index.WriteString("| Package | Files | Functions | Exported | Stub |\n")
+	index.WriteString("|---------|-------|-----------|----------|------|\n")
+
+	for _, pkg := range Existing_sortedKeys(byPkg) {
+		functions := byPkg[pkg]
+		var files []string
+		var exported []FunctionInfo
+		for _, fn := range functions {
+			if !slices.Contains(files, fn.File) {
+				files = append(files, fn.File)
+			}
+			if ast.IsExported(fn.Name) {
+				exported = append(exported, fn)
+			}
+		}
+		sort.Strings(files)
+		sort.SliceStable(exported, func(i, j int) bool {
+			return Existing_readmeFunctionName(exported[i]) < Existing_readmeFunctionName(exported[j])
+		})
+
+		var b strings.Builder
+		b.WriteString(fmt.Sprintf("# Package `%s`\n\n", pkg))
+		b.WriteString("> TODO: describe what this package is for and how the rest of the project uses it.\n\n")
+		b.WriteString(fmt.Sprintf("**Files:** %d  |  **Functions:** %d  |  **Exported:** %d\n\n", len(files), len(functions), len(exported)))
+		b.WriteString("## Files\n\n")
+		for _, file := range files {
+			b.WriteString(fmt.Sprintf("- `%s`\n", file))
+		}
+		b.WriteString("\n## Exported Functions\n\n")
+		if len(exported) == 0 {
+			b.WriteString("_No exported functions._\n")
+		} else {
+			b.WriteString("| Function | Purpose |\n")
+			b.WriteString("|----------|---------|\n")
+			for _, fn := range exported {
+				b.WriteString(fmt.Sprintf("| `%s` | %s |\n", Existing_readmeFunctionName(fn), Existing_readmePurpose(fn)))
+			}
+		}
+
+		name := Existing_safeFileName(pkg) + ".md"
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(b.String()), 0644); err != nil {
+			return err
+		}
+		index.WriteString(fmt.Sprintf("| `%s` | %d | %d | %d | [%s](%s) |\n", pkg, len(files), len(functions), len(exported), name, name))
+	}
+
+	return os.WriteFile(filepath.Join(dir, "index.md"), []byte(index.String()), 0644)
+}
+
+// Existing_readmeFunctionName returns Receiver.Name for methods and Name for functions
+func Existing_readmeFunctionName(fn FunctionInfo) string {
+	if fn.Receiver != "" {
+		return fn.Receiver + "." + fn.Name
+	}
+	return fn.Name
+}
+
+// Existing_readmePurpose returns a function's one-line purpose for a README table: the doc comment,
+// the name-based guess marked as inferred, or a TODO when there is neither
+func Existing_readmePurpose(fn FunctionInfo) string {
+	switch {
+	case fn.Doc != "":
+		return strings.ReplaceAll(fn.Doc, "|", "\\|")
+	case fn.PurposeSource == purposeHeuristic:
+		return "*" + strings.ReplaceAll(fn.Purpose, "|", "\\|") + "* (inferred)"
+	}
+	return "TODO"
+}
@@ -0,0 +1,180 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING REVERSE INDEX - WHO CALLS EACH FUNCTION
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The dependency diagrams answer "what does this function call?".
+             This file answers the opposite question for impact analysis
+             before a refactor: for every function, which functions call it.
+             Edges come from go/types with -typed, otherwise from the call
+             expressions of the scanned files matched by function name.
+
+TO USE THIS FILE:
+1. Runs automatically as the "reverse-index" registered generator
+2. Or call Existing_WriteReverseIndex(outDir, edges) with caller -> callees
+
+FEATURES:
+- Existing_reverse_index.md - Function -> callers table
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// reverseIndexGenerator runs Existing_WriteReverseIndex from the generator registry
+type reverseIndexGenerator struct{}
+
+func init() { Register(reverseIndexGenerator{}) }
+
+func (reverseIndexGenerator) Name() string { return "reverse-index" }
+
+func (reverseIndexGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	if structure == nil {
+		return nil
+	}
+	edges, err := Existing_callEdges(structure)
+	if err != nil {
+		return err
+	}
+	return Existing_WriteReverseIndex(cfg.OutDir, edges)
+}
+
+// Existing_WriteReverseIndex writes a function -> callers table from caller -> callees edges
+func Existing_WriteReverseIndex(outDir string, edges map[string][]string) error {
+	callers := make(map[string]map[string]bool)
+	for caller, callees := range edges {
+		if _, ok := callers[caller]; !ok {
+			callers[caller] = make(map[string]bool)
+		}
+		for _, callee := range callees {
+			if callers[callee] == nil {
+				callers[callee] = make(map[string]bool)
+			}
+			if callee != caller {
+				callers[callee][caller] = true
+			}
+		}
+	}
+
+	functions := make([]string, 0, len(callers))
+	for name := range callers {
+		functions = append(functions, name)
+	}
+	// Most-called first: those are the riskiest to change
+	sort.Slice(functions, func(i, j int) bool {
+		a, b := len(callers[functions[i]]), len(callers[functions[j]])
+		if a != b {
+			return a > b
+		}
+		return functions[i] < functions[j]
+	})
+
+	var b strings.Builder
+	b.WriteString("# Existing Reverse Index - Auto-Generated\n\n")
+	b.WriteString("Who calls each function, most-called first. Changing a function near the top affects every caller listed next to it.\n\n")
+	b.WriteString("| Function | Callers | Called By |\n")
+	b.WriteString("|----------|---------|-----------|\n")
+	uncalled := 0
+	for _, name := range functions {
+		names := make([]string, 0, len(callers[name]))
+		for caller := range callers[name] {
+			names = append(names, "`"+caller+"`")
+		}
+		sort.Strings(names)
+		calledBy := strings.Join(names, ", ")
+		if len(names) == 0 {
+			calledBy = "_no callers in the project_"
+			uncalled++
+		}
+		b.WriteString(fmt.Sprintf("| **%s** | %d | %s |\n", name, len(names), calledBy))
+	}
+	b.WriteString("\n## Summary\n\n")
+	b.WriteString(fmt.Sprintf("- **Functions:** %d\n", len(functions)))
+	b.WriteString(fmt.Sprintf("- **Without callers:** %d (entry points, handlers wired by name, or dead code)\n", uncalled))
+
+	path := filepath.Join(outDir, "Existing_reverse_index.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_callEdges returns caller -> callees for the scanned functions, type-checked with -typed
+func Existing_callEdges(structure *ProjectStructure) (map[string][]string, error) {
+	edges := make(map[string][]string)
+	if typedMode {
+		typed, err := Existing_typedCallEdges(structure.Root)
+		if err == nil {
+			// The reports here are keyed by bare name, so methods sharing a name share a row
+			for _, edge := range typed {
+				if !slices.Contains(edges[edge.From.Name], edge.To.Name) {
+					edges[edge.From.Name] = append(edges[edge.From.Name], edge.To.Name)
+				}
+			}
+			return edges, nil
+		}
+		fmt.Printf("⚠️  -typed: %v (reverse index falls back to name matching)\n", err)
+	}
+
+	known := make(map[string]bool, len(structure.Functions))
+	for _, fn := range structure.Functions {
+		known[fn.Name] = true
+	}
+	for _, file := range structure.Files {
+		node, err := parser.ParseFile(token.NewFileSet(), filepath.Join(structure.Root, filepath.FromSlash(file)), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range node.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			// Keep functions that call nothing so they still appear in the index
+			if _, ok := edges[fn.Name.Name]; !ok {
+				edges[fn.Name.Name] = nil
+			}
+			seen := make(map[string]bool)
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				var name string
+				switch fun := call.Fun.(type) {
+				case *ast.Ident:
+					name = fun.Name
+				case *ast.SelectorExpr:
+					name = fun.Sel.Name
+				}
+				if known[name] && !seen[name] {
+					seen[name] = true
+					edges[fn.Name.Name] = append(edges[fn.Name.Name], name)
+				}
+				return true
+			})
+		}
+	}
+	return edges, nil
+}
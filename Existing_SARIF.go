@@ -0,0 +1,240 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING SARIF - MACHINE-READABLE LINT FINDINGS
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file collects the tool's findings into a SARIF 2.1.0 log so
+             they can be uploaded to GitHub's Security tab (or any other SARIF
+             viewer). Three rules are reported:
+             - BT001 unused-function: unexported functions never referenced
+             - BT002 sql-injection-risk: SQL built with concatenation/Sprintf
+             - BT003 unwired-handler: Handle* methods never referenced by routes
+
+TO USE THIS FILE:
+1. Run with -sarif out.sarif
+2. Upload with github/codeql-action/upload-sarif (sarif_file: out.sarif)
+
+NOTES:
+- References are matched by name across every .go file under the root, so a
+  function is only reported when its name appears nowhere else
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// sarifLog is the top level of a SARIF 2.1.0 file
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string       `json:"id"`
+	Name                 string       `json:"name"`
+	ShortDescription     sarifMessage `json:"shortDescription"`
+	DefaultConfiguration struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI       string `json:"uri"`
+			URIBaseID string `json:"uriBaseId"`
+		} `json:"artifactLocation"`
+		Region struct {
+			StartLine int `json:"startLine"`
+		} `json:"region"`
+	} `json:"physicalLocation"`
+}
+
+// sarifRules are the rules this tool reports, in ID order
+var sarifRules = []struct {
+	ID, Name, Level, Description string
+}{
+	{"BT001", "unused-function", "note", "Unexported function is never referenced in the project"},
+	{"BT002", "sql-injection-risk", "warning", "SQL query is built with string concatenation or fmt.Sprintf"},
+	{"BT003", "unwired-handler", "warning", "HTTP handler method is never referenced, so no route calls it"},
+}
+
+// Existing_WriteSARIF writes the unused-function, SQL injection and unwired-handler findings as SARIF 2.1.0
+func Existing_WriteSARIF(path, root string, structure *ProjectStructure) error {
+	refs, err := Existing_collectReferences(root)
+	if err != nil {
+		return fmt.Errorf("collect references: %w", err)
+	}
+
+	// Vendored code is not the project's to fix; its references still count above
+	var results []sarifResult
+	for _, fn := range Existing_ownFunctions(structure) {
+		switch {
+		case Existing_isHandlerMethod(fn) && !refs[fn.Name]:
+			results = append(results, Existing_sarifResult("BT003", fn.File, fn.Line,
+				fmt.Sprintf("Handler %s.%s is not referenced by any route", fn.Receiver, fn.Name)))
+		case !fn.IsMethod && !ast.IsExported(fn.Name) && fn.Name != "main" && fn.Name != "init" && !refs[fn.Name]:
+			results = append(results, Existing_sarifResult("BT001", fn.File, fn.Line,
+				fmt.Sprintf("Function %s is never used", fn.Name)))
+		}
+	}
+
+	queries, err := Existing_scanSQLQueries(root)
+	if err != nil {
+		return fmt.Errorf("scan SQL queries: %w", err)
+	}
+	for _, q := range queries {
+		if q.Risky {
+			results = append(results, Existing_sarifResult("BT002", q.File, q.Line,
+				fmt.Sprintf("%s on %s in %s is assembled from strings; use query parameters", q.Statement, q.Table, q.Function)))
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i].Locations[0].PhysicalLocation, results[j].Locations[0].PhysicalLocation
+		if a.ArtifactLocation.URI != b.ArtifactLocation.URI {
+			return a.ArtifactLocation.URI < b.ArtifactLocation.URI
+		}
+		return a.Region.StartLine < b.Region.StartLine
+	})
+
+	driver := sarifDriver{Name: "BTProject_Builder_Evaluator", InformationURI: "https://github.com/PhoenixWeaver/BTPW_Project_Builder_Evaluator"}
+	for _, r := range sarifRules {
+		rule := sarifRule{ID: r.ID, Name: r.Name, ShortDescription: sarifMessage{Text: r.Description}}
+		rule.DefaultConfiguration.Level = r.Level
+		driver.Rules = append(driver.Rules, rule)
+	}
+	if results == nil {
+		results = []sarifResult{} // SARIF requires an array, not null
+	}
+	doc := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal sarif: %w", err)
+	}
+	if dir := filepath.Dir(path); dir != "." {
+		if err := ensureDir(dir); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return err
+	}
+	fmt.Printf("🛡️  SARIF written to %s (%d findings)\n", path, len(results))
+	return nil
+}
+
+// Existing_sarifResult builds one result using the rule's default level
+func Existing_sarifResult(ruleID, file string, line int, message string) sarifResult {
+	result := sarifResult{RuleID: ruleID, Message: sarifMessage{Text: message}}
+	for _, r := range sarifRules {
+		if r.ID == ruleID {
+			result.Level = r.Level
+		}
+	}
+	var loc sarifLocation
+	loc.PhysicalLocation.ArtifactLocation.URI = file
+	loc.PhysicalLocation.ArtifactLocation.URIBaseID = "%SRCROOT%"
+	loc.PhysicalLocation.Region.StartLine = line
+	result.Locations = []sarifLocation{loc}
+	return result
+}
+
+// Existing_isHandlerMethod reports whether fn looks like an HTTP handler method (Handle* on a *Handler type)
+func Existing_isHandlerMethod(fn FunctionInfo) bool {
+	return fn.IsMethod && strings.HasPrefix(fn.Name, "Handle") && strings.HasSuffix(fn.Receiver, "Handler")
+}
+
+// Existing_collectReferences returns every identifier used outside its own declaration name in .go files under root
+func Existing_collectReferences(root string) (map[string]bool, error) {
+	refs := make(map[string]bool)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		node, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return nil // unparsable files do not block the report
+		}
+
+		// Declaration names are not references
+		declared := make(map[*ast.Ident]bool)
+		for _, decl := range node.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok {
+				declared[fn.Name] = true
+			}
+		}
+		ast.Inspect(node, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && !declared[ident] {
+				refs[ident.Name] = true
+			}
+			return true
+		})
+		return nil
+	})
+	return refs, err
+}
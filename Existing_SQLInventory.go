@@ -0,0 +1,270 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING SQL INVENTORY - REAL QUERIES FOUND IN STORE FILES
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file scans the store and database files of the current
+             project for SQL string literals and lists every query with its
+             location, enclosing function and table. Queries assembled with
+             string concatenation or fmt.Sprintf are flagged as possible
+             SQL injection risks.
+
+TO USE THIS FILE:
+1. Runs automatically as the "sql-inventory" registered generator
+2. Or call Existing_WriteSQLInventory() with the output dir and project root
+3. The report is saved as Existing_sql_inventory.md
+
+FEATURES:
+- Existing_sql_inventory.md - SQL queries grouped by table
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SQLQueryInfo represents a SQL string literal discovered in the project
+type SQLQueryInfo struct {
+	File      string
+	Line      int
+	Function  string
+	Statement string
+	Table     string
+	Query     string
+	Risky     bool // built with concatenation or fmt.Sprintf
+}
+
+var (
+	sqlStatementPattern = regexp.MustCompile(`(?i)\b(SELECT|INSERT|UPDATE|DELETE|CREATE\s+TABLE)\b`)
+	sqlTablePattern     = regexp.MustCompile(`(?i)\b(?:FROM|INTO|UPDATE|TABLE(?:\s+IF\s+NOT\s+EXISTS)?)\s+([A-Za-z_][A-Za-z0-9_.]*)`)
+)
+
+// sqlInventoryGenerator runs Existing_WriteSQLInventory from the generator registry
+type sqlInventoryGenerator struct{}
+
+func init() { Register(sqlInventoryGenerator{}) }
+
+func (sqlInventoryGenerator) Name() string { return "sql-inventory" }
+
+func (sqlInventoryGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	return Existing_WriteSQLInventory(cfg.OutDir, cfg.Root)
+}
+
+// Existing_WriteSQLInventory scans store/database files for SQL literals and writes a report grouped by table
+func Existing_WriteSQLInventory(outDir, root string) error {
+	queries, err := Existing_scanSQLQueries(root)
+	if err != nil {
+		return fmt.Errorf("scan SQL queries: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("# Existing SQL Inventory - Auto-Generated\n\n")
+	b.WriteString("This report lists every SQL string literal found in the store and database files of the project.\n")
+	b.WriteString("Queries marked ⚠️ are assembled with string concatenation or `fmt.Sprintf` and may be open to SQL injection.\n\n")
+
+	if len(queries) == 0 {
+		b.WriteString("_No SQL queries found in store or database files._\n")
+		return os.WriteFile(filepath.Join(outDir, "Existing_sql_inventory.md"), []byte(b.String()), 0644)
+	}
+
+	// Group by table
+	groups := make(map[string][]SQLQueryInfo)
+	risky := 0
+	for _, q := range queries {
+		groups[q.Table] = append(groups[q.Table], q)
+		if q.Risky {
+			risky++
+		}
+	}
+	tables := make([]string, 0, len(groups))
+	for table := range groups {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	for _, table := range tables {
+		b.WriteString(fmt.Sprintf("## Table: %s\n\n", table))
+		b.WriteString("| Statement | Location | Function | Query | Risk |\n")
+		b.WriteString("|-----------|----------|----------|-------|------|\n")
+		for _, q := range groups[table] {
+			riskLabel := "✅"
+			if q.Risky {
+				riskLabel = "⚠️ concatenated"
+			}
+			b.WriteString(fmt.Sprintf("| %s | `%s:%d` | %s | `%s` | %s |\n",
+				q.Statement, q.File, q.Line, q.Function, Existing_compactSQL(q.Query), riskLabel))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Summary\n\n")
+	b.WriteString(fmt.Sprintf("- **Total Queries:** %d\n", len(queries)))
+	b.WriteString(fmt.Sprintf("- **Tables:** %d\n", len(tables)))
+	b.WriteString(fmt.Sprintf("- **Possible Injection Risks:** %d\n", risky))
+
+	path := filepath.Join(outDir, "Existing_sql_inventory.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_scanSQLQueries walks store/database files under root and extracts SQL string literals
+func Existing_scanSQLQueries(root string) ([]SQLQueryInfo, error) {
+	var queries []SQLQueryInfo
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		lower := strings.ToLower(filepath.ToSlash(path))
+		if !strings.Contains(lower, "store") && !strings.Contains(lower, "database") {
+			return nil
+		}
+
+		found, err := Existing_extractSQLQueries(path)
+		if err != nil {
+			// One broken file should not cost the whole inventory
+			fmt.Printf("⚠️  SQL inventory skipped %s: %v\n", Existing_relSlash(root, path), err)
+			return nil
+		}
+		for i := range found {
+			found[i].File = Existing_relSlash(root, path)
+		}
+		queries = append(queries, found...)
+		return nil
+	})
+
+	return queries, err
+}
+
+// Existing_extractSQLQueries parses one Go file and returns its SQL literals
+func Existing_extractSQLQueries(filePath string) ([]SQLQueryInfo, error) {
+	fset := token.NewFileSet()
+	node, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// First pass: remember literals that take part in concatenation or Sprintf
+	risky := make(map[*ast.BasicLit]bool)
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.BinaryExpr:
+			if x.Op != token.ADD {
+				return true
+			}
+			_, xLit := x.X.(*ast.BasicLit)
+			_, yLit := x.Y.(*ast.BasicLit)
+			if xLit && yLit {
+				return true
+			}
+			for _, side := range []ast.Expr{x.X, x.Y} {
+				ast.Inspect(side, func(m ast.Node) bool {
+					if lit, ok := m.(*ast.BasicLit); ok {
+						risky[lit] = true
+					}
+					return true
+				})
+			}
+		case *ast.CallExpr:
+			if sel, ok := x.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Sprintf" && len(x.Args) > 1 {
+				if lit, ok := x.Args[0].(*ast.BasicLit); ok {
+					risky[lit] = true
+				}
+			}
+		}
+		return true
+	})
+
+	var queries []SQLQueryInfo
+	for _, decl := range node.Decls {
+		enclosing := "(package level)"
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			enclosing = fn.Name.Name
+			if fn.Recv != nil && len(fn.Recv.List) > 0 {
+				recvType := fn.Recv.List[0].Type
+				if star, ok := recvType.(*ast.StarExpr); ok {
+					recvType = star.X
+				}
+				if ident, ok := recvType.(*ast.Ident); ok {
+					enclosing = ident.Name + "." + fn.Name.Name
+				}
+			}
+		}
+
+		ast.Inspect(decl, func(n ast.Node) bool {
+			lit, ok := n.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			value, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return true
+			}
+			statement := sqlStatementPattern.FindString(value)
+			if statement == "" {
+				return true
+			}
+			table := "(unknown)"
+			m := sqlTablePattern.FindStringSubmatch(value)
+			if m != nil {
+				table = strings.ToLower(m[1])
+			} else if statement != strings.ToUpper(statement) {
+				return true // "failed to delete user" is prose, not lower-case SQL
+			}
+			statement = strings.ToUpper(strings.Join(strings.Fields(statement), " "))
+
+			queries = append(queries, SQLQueryInfo{
+				File:      filePath,
+				Line:      fset.Position(lit.Pos()).Line,
+				Function:  enclosing,
+				Statement: statement,
+				Table:     table,
+				Query:     value,
+				Risky:     risky[lit],
+			})
+			return true
+		})
+	}
+
+	return queries, nil
+}
+
+// Existing_compactSQL collapses whitespace and escapes pipes so a query fits in a Markdown table cell
+func Existing_compactSQL(query string) string {
+	compact := strings.Join(strings.Fields(query), " ")
+	compact = strings.ReplaceAll(compact, "|", "\\|")
+	compact = strings.ReplaceAll(compact, "`", "'")
+	return Existing_truncate(compact, 120)
+}
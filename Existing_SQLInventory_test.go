@@ -0,0 +1,50 @@
+//go:build flowcharts
+
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestScanSQLQueriesLowerCaseAndBrokenFiles(t *testing.T) {
+	root := t.TempDir()
+	store := filepath.Join(root, "store")
+	if err := os.MkdirAll(store, 0755); err != nil {
+		t.Fatal(err)
+	}
+	good := "package store\n\nconst q = \"select id from users where id = $1\"\n\nvar msg = \"failed to delete user\"\n"
+	if err := os.WriteFile(filepath.Join(store, "user_store.go"), []byte(good), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(store, "broken_store.go"), []byte("package store\nfunc {"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	queries, err := Existing_scanSQLQueries(root)
+	if err != nil {
+		t.Fatalf("a broken file aborted the inventory: %v", err)
+	}
+	if len(queries) != 1 || queries[0].Statement != "SELECT" || queries[0].Table != "users" {
+		t.Errorf("queries = %+v, want the one lower-case SELECT from users", queries)
+	}
+}
+
+func TestCompactSQLIsRuneSafe(t *testing.T) {
+	got := Existing_compactSQL("SELECT '" + strings.Repeat("é", 200) + "'")
+	if !utf8.ValidString(got) || utf8.RuneCountInString(got) != 120 {
+		t.Errorf("Existing_compactSQL = %q", got)
+	}
+}
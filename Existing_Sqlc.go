@@ -0,0 +1,338 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING SQLC - GENERATED STORE METHODS AND THEIR QUERIES
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: Projects whose data layer is generated by sqlc wrap named queries
+             in methods on *Queries, so the name heuristics of the other
+             reports miss them. This file detects sqlc (a sqlc.yaml/.yml/.json
+             config, or a db.go/querier.go with //go:generate sqlc), reads the
+             query files the config points at, and maps every
+             "-- name: X :kind" query to the generated Go method.
+
+TO USE THIS FILE:
+1. Runs automatically as the "sqlc" registered generator
+2. Nothing is written when the project does not use sqlc
+
+FEATURES:
+- Existing_sqlc_queries.md - Generated method -> SQL mapping
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SqlcQuery is one named query from a sqlc query file
+type SqlcQuery struct {
+	Name      string // generated method name
+	Kind      string // :one, :many, :exec, ...
+	QueryFile string
+	Line      int
+	SQL       string
+	GoFile    string // where the generated method lives ("" if not found)
+	GoLine    int
+}
+
+var (
+	sqlcNamePattern     = regexp.MustCompile(`^--\s*name:\s*(\w+)\s*(:\w+)?`)
+	sqlcQueriesPattern  = regexp.MustCompile(`^(\s*)(?:-\s*)?"?queries"?\s*:\s*(.*)$`)
+	sqlcListItemPattern = regexp.MustCompile(`^(\s*)-\s*(.+)$`)
+)
+
+// sqlcGenerator runs Existing_WriteSqlcReport from the generator registry
+type sqlcGenerator struct{}
+
+func init() { Register(sqlcGenerator{}) }
+
+func (sqlcGenerator) Name() string { return "sqlc" }
+
+func (sqlcGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	return Existing_WriteSqlcReport(cfg.OutDir, cfg.Root)
+}
+
+// Existing_WriteSqlcReport writes the sqlc method -> SQL mapping when the project uses sqlc
+func Existing_WriteSqlcReport(outDir, root string) error {
+	configs, generated, err := Existing_detectSqlc(root)
+	if err != nil {
+		return fmt.Errorf("detect sqlc: %w", err)
+	}
+	if len(configs) == 0 && len(generated) == 0 {
+		return nil
+	}
+	fmt.Println("🧬 sqlc detected - mapping generated methods to their SQL")
+
+	// Query files come from the config; without one, look for the sqlc defaults
+	var queryFiles []string
+	for _, config := range configs {
+		files, err := Existing_sqlcQueryFiles(config)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", config, err)
+		}
+		queryFiles = append(queryFiles, files...)
+	}
+	if len(configs) == 0 {
+		for _, dir := range generated {
+			matches, _ := filepath.Glob(filepath.Join(dir, "*.sql"))
+			queryFiles = append(queryFiles, matches...)
+		}
+	}
+
+	var queries []SqlcQuery
+	for _, file := range queryFiles {
+		found, err := Existing_parseSqlcQueries(file)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", file, err)
+		}
+		for i := range found {
+			found[i].QueryFile = Existing_relSlash(root, file)
+		}
+		queries = append(queries, found...)
+	}
+
+	// Locate the generated methods on *Queries
+	methods, err := Existing_findQueriesMethods(root)
+	if err != nil {
+		return err
+	}
+	for i := range queries {
+		if pos, ok := methods[queries[i].Name]; ok {
+			queries[i].GoFile = pos.Filename
+			queries[i].GoLine = pos.Line
+		}
+	}
+	sort.Slice(queries, func(i, j int) bool { return queries[i].Name < queries[j].Name })
+
+	var b strings.Builder
+	b.WriteString("# Existing sqlc Queries - Auto-Generated\n\n")
+	b.WriteString("The store layer of this project is generated by sqlc. Each generated method below runs the named query shown next to it.\n\n")
+	b.WriteString("**Config:** ")
+	if len(configs) == 0 {
+		b.WriteString("none found (detected via `//go:generate sqlc`)\n\n")
+	} else {
+		for i, config := range configs {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString("`" + Existing_relSlash(root, config) + "`")
+		}
+		b.WriteString("\n\n")
+	}
+
+	if len(queries) == 0 {
+		b.WriteString("_No `-- name:` queries found in the configured query files._\n")
+	} else {
+		b.WriteString("| Method | Kind | Generated At | Query Source | SQL |\n")
+		b.WriteString("|--------|------|--------------|--------------|-----|\n")
+		missing := 0
+		for _, q := range queries {
+			goLoc := "⚠️ not generated yet"
+			if q.GoFile != "" {
+				goLoc = fmt.Sprintf("`%s:%d`", q.GoFile, q.GoLine)
+			} else {
+				missing++
+			}
+			b.WriteString(fmt.Sprintf("| **%s** | `%s` | %s | `%s:%d` | `%s` |\n",
+				q.Name, q.Kind, goLoc, q.QueryFile, q.Line, Existing_compactSQL(q.SQL)))
+		}
+		b.WriteString(fmt.Sprintf("\n## Summary\n\n- **Named Queries:** %d\n- **Missing Generated Methods:** %d\n", len(queries), missing))
+		if missing > 0 {
+			b.WriteString("\nRun `sqlc generate` to create the missing methods.\n")
+		}
+	}
+
+	path := filepath.Join(outDir, "Existing_sqlc_queries.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_detectSqlc returns sqlc config files and the dirs of Go files carrying //go:generate sqlc
+func Existing_detectSqlc(root string) (configs, generated []string, err error) {
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) || info.Name() == "node_modules" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch info.Name() {
+		case "sqlc.yaml", "sqlc.yml", "sqlc.json":
+			configs = append(configs, path)
+		case "db.go", "querier.go":
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if strings.Contains(string(data), "//go:generate sqlc") || strings.Contains(string(data), "// Code generated by sqlc") {
+				generated = append(generated, filepath.Dir(path))
+			}
+		}
+		return nil
+	})
+	return configs, generated, err
+}
+
+// Existing_sqlcQueryFiles returns the .sql files listed under "queries" in a sqlc config.
+// Only the "queries" keys are read, so no YAML library is needed.
+func Existing_sqlcQueryFiles(config string) ([]string, error) {
+	f, err := os.Open(config)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []string
+	listIndent := -1
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if listIndent >= 0 {
+			if m := sqlcListItemPattern.FindStringSubmatch(line); m != nil && len(m[1]) >= listIndent {
+				entries = append(entries, m[2])
+				continue
+			}
+			listIndent = -1
+		}
+		m := sqlcQueriesPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		value := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(m[2]), ","))
+		switch {
+		case value == "":
+			listIndent = len(m[1])
+		case strings.HasPrefix(value, "["):
+			// JSON or flow-style list: ["a.sql", "b/"]
+			for _, item := range strings.Split(strings.Trim(value, "[]"), ",") {
+				entries = append(entries, item)
+			}
+		default:
+			entries = append(entries, value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// Entries are files or directories relative to the config
+	base := filepath.Dir(config)
+	var files []string
+	for _, entry := range entries {
+		entry = strings.Trim(strings.TrimSpace(entry), `"'`)
+		if entry == "" {
+			continue
+		}
+		path := filepath.Join(base, filepath.FromSlash(entry))
+		if dirExists(path) {
+			matches, _ := filepath.Glob(filepath.Join(path, "*.sql"))
+			files = append(files, matches...)
+		} else if fileExists(path) {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
+
+// Existing_parseSqlcQueries splits a sqlc query file on its "-- name:" annotations
+func Existing_parseSqlcQueries(path string) ([]SqlcQuery, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var queries []SqlcQuery
+	var current *SqlcQuery
+	var body strings.Builder
+	flush := func() {
+		if current != nil {
+			current.SQL = strings.TrimSpace(body.String())
+			queries = append(queries, *current)
+		}
+		body.Reset()
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		if m := sqlcNamePattern.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			flush()
+			current = &SqlcQuery{Name: m[1], Kind: m[2], Line: i + 1}
+			continue
+		}
+		if current != nil && !strings.HasPrefix(strings.TrimSpace(line), "--") {
+			body.WriteString(line + "\n")
+		}
+	}
+	flush()
+	return queries, nil
+}
+
+// Existing_findQueriesMethods returns the position of every method declared on Queries
+func Existing_findQueriesMethods(root string) (map[string]token.Position, error) {
+	methods := make(map[string]token.Position)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil || !strings.Contains(string(data), "*Queries)") {
+			return err
+		}
+
+		fset := token.NewFileSet()
+		node, err := parser.ParseFile(fset, path, data, 0)
+		if err != nil {
+			return nil // generated code that does not parse is reported as missing
+		}
+		for _, decl := range node.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if ident, ok := recv.(*ast.Ident); ok && ident.Name == "Queries" {
+				pos := fset.Position(fn.Pos())
+				pos.Filename = Existing_relSlash(root, path)
+				methods[fn.Name.Name] = pos
+			}
+		}
+		return nil
+	})
+	return methods, err
+}
@@ -0,0 +1,190 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING PACKAGE TREEMAP - WHERE THE CODE MASS IS
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file writes an HTML treemap with one rectangle per package
+             (directory), sized by lines of code or by function count - a
+             toggle on the page switches between the two. By default the page
+             lays the treemap out with D3 from the jsDelivr CDN; with -offline
+             a small squarified layout (assets/treemap.js) is embedded in the
+             page instead, so it opens without network access.
+
+TO USE THIS FILE:
+1. Runs with the other dynamic reports after the project scan
+2. Add -offline to embed the layout script instead of loading D3
+3. Open Existing_package_treemap.html in a browser
+
+FEATURES:
+- Existing_package_treemap.html - Treemap of package sizes (LOC / functions)
+
+===============================================================================
+*/
+
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// offlineMode embeds JavaScript into generated HTML instead of loading it from a CDN (set from -offline)
+var offlineMode bool
+
+// d3ScriptURL is the D3 build the treemap loads when not offline
+const d3ScriptURL = "https://cdn.jsdelivr.net/npm/d3@7/dist/d3.min.js"
+
+//go:embed assets/treemap.js
+var treemapLayoutJS string
+
+// d3TreemapLayoutJS adapts d3.treemap to the btTreemap(items, width, height) signature of assets/treemap.js
+const d3TreemapLayoutJS = `function btTreemap(items, width, height) {
+  var root = d3.hierarchy({ children: items.filter(function (d) { return d.value > 0; }) })
+    .sum(function (d) { return d.value || 0; })
+    .sort(function (a, b) { return b.value - a.value; });
+  d3.treemap().size([width, height]).paddingInner(2)(root);
+  return root.leaves().filter(function (l) { return l !== root; }).map(function (l) {
+    return { x0: l.x0, y0: l.y0, x1: l.x1, y1: l.y1, data: l.data };
+  });
+}`
+
+// treemapPackage is one rectangle of the treemap
+type treemapPackage struct {
+	Name      string `json:"name"`
+	Files     int    `json:"files"`
+	LOC       int    `json:"loc"`
+	Functions int    `json:"functions"`
+}
+
+// Existing_WritePackageTreemap writes an HTML treemap of package sizes by LOC or function count
+func Existing_WritePackageTreemap(outDir string, structure *ProjectStructure) error {
+	packages := Existing_treemapPackages(structure)
+	data, err := json.Marshal(packages)
+	if err != nil {
+		return fmt.Errorf("encode treemap data: %w", err)
+	}
+
+	script := fmt.Sprintf("<script src=\"%s\"></script>\n<script>\n%s\n</script>", d3ScriptURL, d3TreemapLayoutJS)
+	if offlineMode {
+		script = "<script>\n" + treemapLayoutJS + "</script>"
+	}
+
+	page := fmt.Sprintf(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Package Treemap - %s</title>
+    <style>
+        body { font-family: Arial, sans-serif; margin: 20px; background: #f5f5f5; }
+        h1 { color: #333; }
+        .controls { margin-bottom: 12px; }
+        #treemap { position: relative; width: 100%%; height: 600px; background: #fff; border: 1px solid #ccc; }
+        .cell { position: absolute; box-sizing: border-box; border: 1px solid #fff; overflow: hidden; padding: 4px; font-size: 12px; color: #222; }
+        .cell strong { display: block; }
+        .empty { padding: 20px; color: #666; }
+    </style>
+</head>
+<body>
+    <h1>📦 Package Treemap</h1>
+    <div class="controls">
+        Size by:
+        <label><input type="radio" name="metric" value="loc" checked> Lines of code</label>
+        <label><input type="radio" name="metric" value="functions"> Functions</label>
+    </div>
+    <div id="treemap"></div>
+    %s
+    <script>
+        var packages = %s;
+        var colors = ["#bbdefb", "#c8e6c9", "#ffe0b2", "#f8bbd0", "#d1c4e9", "#b2ebf2", "#fff9c4", "#ffccbc"];
+        function render() {
+            var metric = document.querySelector("input[name=metric]:checked").value;
+            var box = document.getElementById("treemap");
+            box.innerHTML = "";
+            var items = packages.map(function (p, i) {
+                return { name: p.name, files: p.files, loc: p.loc, functions: p.functions, value: p[metric], color: colors[i %% colors.length] };
+            });
+            var rects = btTreemap(items, box.clientWidth, box.clientHeight);
+            if (!rects.length) {
+                box.innerHTML = "<div class=\"empty\">No packages with code found.</div>";
+                return;
+            }
+            rects.forEach(function (r) {
+                var cell = document.createElement("div");
+                cell.className = "cell";
+                cell.style.left = r.x0 + "px";
+                cell.style.top = r.y0 + "px";
+                cell.style.width = (r.x1 - r.x0) + "px";
+                cell.style.height = (r.y1 - r.y0) + "px";
+                cell.style.background = r.data.color;
+                cell.title = r.data.name + "\n" + r.data.loc + " lines, " + r.data.functions + " functions, " + r.data.files + " files";
+                var name = document.createElement("strong");
+                name.textContent = r.data.name;
+                cell.appendChild(name);
+                cell.appendChild(document.createTextNode(r.data.value + (metric === "loc" ? " lines" : " functions")));
+                box.appendChild(cell);
+            });
+        }
+        document.querySelectorAll("input[name=metric]").forEach(function (input) { input.addEventListener("change", render); });
+        window.addEventListener("resize", render);
+        render();
+    </script>
+</body>
+</html>
+`, Existing_architectureTitle(structure), script, data)
+
+	outPath := filepath.Join(outDir, "Existing_package_treemap.html")
+	return os.WriteFile(outPath, []byte(page), 0644)
+}
+
+// Existing_treemapPackages totals files, lines and functions per package directory, largest first
+func Existing_treemapPackages(structure *ProjectStructure) []treemapPackage {
+	byDir := make(map[string]*treemapPackage)
+	get := func(file string) *treemapPackage {
+		dir := path.Dir(file)
+		if byDir[dir] == nil {
+			byDir[dir] = &treemapPackage{Name: dir}
+		}
+		return byDir[dir]
+	}
+	for _, file := range structure.Files {
+		pkg := get(file)
+		pkg.Files++
+		content, err := os.ReadFile(filepath.Join(structure.Root, filepath.FromSlash(file)))
+		if err != nil {
+			continue // a vanished file only loses its line count
+		}
+		pkg.LOC += strings.Count(string(content), "\n")
+	}
+	for _, fn := range structure.Functions {
+		get(fn.File).Functions++
+	}
+
+	packages := make([]treemapPackage, 0, len(byDir))
+	for _, pkg := range byDir {
+		packages = append(packages, *pkg)
+	}
+	sort.Slice(packages, func(i, j int) bool {
+		if packages[i].LOC != packages[j].LOC {
+			return packages[i].LOC > packages[j].LOC
+		}
+		return packages[i].Name < packages[j].Name
+	})
+	return packages
+}
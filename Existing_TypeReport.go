@@ -0,0 +1,174 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING TYPE REPORT - STRUCTS, FIELD COUNTS AND COMPOSITION
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The project scan records every named type next to the
+             functions (ProjectStructure.Types). This file turns the structs
+             among them into a data-model overview: per package, each struct
+             with its field count, its embedded types and whether its fields
+             carry `json` or `db` tags. Embedded fields - an *ast.Field with no
+             names - are composition, so they are also drawn as a Mermaid
+             class diagram (Outer *-- Embedded).
+
+TO USE THIS FILE:
+1. Runs with the other dynamic reports after the project scan
+2. Read Existing_type_report.md
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// TypeInfo represents a discovered named type; the field details are only set for structs
+type TypeInfo struct {
+	Name     string
+	File     string
+	Package  string
+	Line     int
+	Kind     string   // "struct", "interface" or "other"
+	Fields   int      // number of fields, an embedded field counts as one
+	Embedded []string // embedded types as written, e.g. "User" or "*sql.DB"
+	JSONTags bool     // at least one field has a json tag
+	DBTags   bool     // at least one field has a db tag
+}
+
+// Existing_extractTypes returns the named types declared in a parsed file
+func Existing_extractTypes(fset *token.FileSet, node *ast.File) []TypeInfo {
+	var found []TypeInfo
+	ast.Inspect(node, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		info := TypeInfo{
+			Name:    spec.Name.Name,
+			Package: node.Name.Name,
+			Line:    fset.Position(spec.Pos()).Line,
+			Kind:    "other",
+		}
+		switch t := spec.Type.(type) {
+		case *ast.InterfaceType:
+			info.Kind = "interface"
+		case *ast.StructType:
+			info.Kind = "struct"
+			for _, field := range t.Fields.List {
+				if len(field.Names) == 0 {
+					info.Fields++
+					info.Embedded = append(info.Embedded, types.ExprString(field.Type))
+				} else {
+					info.Fields += len(field.Names)
+				}
+				if field.Tag == nil {
+					continue
+				}
+				tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+				if _, ok := tag.Lookup("json"); ok {
+					info.JSONTags = true
+				}
+				if _, ok := tag.Lookup("db"); ok {
+					info.DBTags = true
+				}
+			}
+		}
+		found = append(found, info)
+		return true
+	})
+	return found
+}
+
+// Existing_WriteTypeReport lists every struct per package with its field count, embedded types and tags
+func Existing_WriteTypeReport(outDir string, structure *ProjectStructure) error {
+	byPkg := make(map[string][]TypeInfo)
+	structs := 0
+	for _, t := range structure.Types {
+		if t.Kind == "struct" {
+			byPkg[t.Package] = append(byPkg[t.Package], t)
+			structs++
+		}
+	}
+	packages := make([]string, 0, len(byPkg))
+	for pkg := range byPkg {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+
+	yesNo := func(b bool) string {
+		if b {
+			return "✅"
+		}
+		return "-"
+	}
+
+	var b strings.Builder
+	b.WriteString("# Existing Type Report - Auto-Generated\n\n")
+	b.WriteString(fmt.Sprintf("%d structs in %d packages. Embedded fields are composition and count as one field.\n\n", structs, len(packages)))
+	if structs == 0 {
+		b.WriteString("No structs found.\n")
+	}
+
+	var composition []string
+	for _, pkg := range packages {
+		list := byPkg[pkg]
+		sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+		b.WriteString(fmt.Sprintf("## 📦 %s: %s\n\n", tr("report.inventory.package"), pkg))
+		b.WriteString("| Struct | Fields | Embedded | json tags | db tags | File |\n")
+		b.WriteString("|--------|--------|----------|-----------|---------|------|\n")
+		for _, t := range list {
+			embedded := "-"
+			if len(t.Embedded) > 0 {
+				embedded = "`" + strings.Join(t.Embedded, "`, `") + "`"
+			}
+			b.WriteString(fmt.Sprintf("| `%s` | %d | %s | %s | %s | `%s:%d` |\n",
+				t.Name, t.Fields, embedded, yesNo(t.JSONTags), yesNo(t.DBTags), t.File, t.Line))
+			for _, e := range t.Embedded {
+				// Qualify same-package types so store.User and api.User stay apart
+				inner := strings.TrimPrefix(e, "*")
+				if !strings.Contains(inner, ".") {
+					inner = pkg + "." + inner
+				}
+				composition = append(composition, fmt.Sprintf("    %s *-- %s : embeds\n",
+					Existing_mermaidID(pkg+"."+t.Name), Existing_mermaidID(inner)))
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## 🧩 Composition\n\n")
+	if len(composition) == 0 {
+		b.WriteString("No embedded types.\n")
+	} else {
+		b.WriteString("```mermaid\n")
+		b.WriteString("classDiagram\n")
+		for _, line := range composition {
+			b.WriteString(line)
+		}
+		b.WriteString("```\n")
+	}
+
+	path := filepath.Join(outDir, "Existing_type_report.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
@@ -0,0 +1,392 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+EXISTING TYPED CALLS - TYPE-CHECKED CALL RESOLUTION (-typed)
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The function dependency diagrams guess edges from function names
+             (NewXHandler depends on NewXStore, ...). With -typed, every package
+             of the module is type-checked with go/types and each call is
+             resolved through types.Info.Uses, so cross-package selectors and
+             method calls land on the real callee. Calls through an interface
+             method become an edge to each module type implementing it.
+             Functions are keyed by package path, receiver and name, so
+             (*UserStore).Get and (*MovieStore).Get stay two nodes.
+
+TO USE THIS FILE:
+1. Run with -typed (the project must build: imports are type-checked from source)
+2. When type-checking fails the diagrams fall back to the name heuristics
+
+NOTES:
+- Uses the standard library's go/types with typedImporter, a small source
+  importer over a private copy of build.Default (Dir set to the module root,
+  cgo off), so no extra module is needed and the process-wide build.Default
+  is never touched; third-party imports must be in the module cache
+- All module packages are checked once, by this importer, into one types
+  universe. With go/importer's "source" importer every package re-checked its
+  module imports into a universe of its own, and types.Implements across two
+  universes is always false, so interface calls to a type in another package
+  were dropped
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// typedMode resolves call edges with go/types instead of name heuristics (set from -typed)
+var typedMode bool
+
+// funcKey identifies a function of the module; methods of different types and functions of
+// different packages that share a name get different keys
+type funcKey struct {
+	PkgPath string // import path of the declaring package
+	Recv    string // receiver type name, pointer and type parameters stripped; "" for functions
+	Name    string
+}
+
+// callEdge is a resolved call from one function to another
+type callEdge struct {
+	From funcKey
+	To   funcKey
+}
+
+// typedPackage is one type-checked package of the module
+type typedPackage struct {
+	Files []*ast.File
+	Pkg   *types.Package
+	Info  *types.Info
+}
+
+// Existing_typedCallEdges type-checks every package of the module containing dir and returns the calls between its functions
+func Existing_typedCallEdges(dir string) ([]callEdge, error) {
+	modRoot, ok := findModuleRoot(dir)
+	if !ok {
+		return nil, fmt.Errorf("no go.mod found above %s", dir)
+	}
+	modPath := readModulePath(filepath.Join(modRoot, "go.mod"))
+	if modPath == "" {
+		return nil, fmt.Errorf("no module path in %s", filepath.Join(modRoot, "go.mod"))
+	}
+
+	fset := token.NewFileSet()
+	byDir, err := Existing_parsePackageDirs(fset, typedBuildContext(modRoot), modRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	imp := newTypedImporter(fset, modRoot, modPath, byDir)
+	dirs := make([]string, 0, len(byDir))
+	for d := range byDir {
+		dirs = append(dirs, d)
+	}
+	sort.Strings(dirs)
+
+	var packages []typedPackage
+	for _, d := range dirs {
+		p, err := imp.checkModuleDir(d)
+		if err != nil {
+			return nil, err
+		}
+		packages = append(packages, *p)
+	}
+
+	modulePackages := make(map[string]bool, len(packages))
+	for _, p := range packages {
+		modulePackages[p.Pkg.Path()] = true
+	}
+
+	// Concrete named types, used to resolve interface method calls
+	var named []*types.Named
+	for _, p := range packages {
+		scope := p.Pkg.Scope()
+		for _, name := range scope.Names() {
+			if tn, ok := scope.Lookup(name).(*types.TypeName); ok {
+				if n, ok := tn.Type().(*types.Named); ok && !types.IsInterface(n) {
+					named = append(named, n)
+				}
+			}
+		}
+	}
+
+	seen := make(map[callEdge]bool)
+	var edges []callEdge
+	add := func(from, to funcKey) {
+		edge := callEdge{From: from, To: to}
+		if !seen[edge] {
+			seen[edge] = true
+			edges = append(edges, edge)
+		}
+	}
+
+	for _, p := range packages {
+		for _, file := range p.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Body == nil {
+					continue
+				}
+				caller, ok := p.Info.Defs[fn.Name].(*types.Func)
+				if !ok {
+					continue
+				}
+				from := Existing_typesFuncKey(caller)
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+					callee := Existing_calleeFunc(p.Info, call)
+					if callee == nil || callee.Pkg() == nil || !modulePackages[callee.Pkg().Path()] {
+						return true
+					}
+					// An interface call lands on the method of every module type implementing the interface
+					if recv := callee.Type().(*types.Signature).Recv(); recv != nil && types.IsInterface(recv.Type()) {
+						for _, impl := range Existing_implementations(named, recv.Type()) {
+							obj, _, _ := types.LookupFieldOrMethod(impl, true, callee.Pkg(), callee.Name())
+							if method, ok := obj.(*types.Func); ok {
+								add(from, Existing_typesFuncKey(method))
+							}
+						}
+						return true
+					}
+					add(from, Existing_typesFuncKey(callee.Origin()))
+					return true
+				})
+			}
+		}
+	}
+	return edges, nil
+}
+
+// Existing_parsePackageDirs parses the non-test Go files under root that match ctxt, grouped by directory
+func Existing_parsePackageDirs(fset *token.FileSet, ctxt *build.Context, root string) (map[string][]*ast.File, error) {
+	byDir := make(map[string][]*ast.File)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if Existing_skipScanDir(root, path, info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		// Honor build constraints so files for other platforms or tags are not mixed in
+		if match, err := ctxt.MatchFile(filepath.Dir(path), info.Name()); err != nil || !match {
+			return err
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		byDir[filepath.Dir(path)] = append(byDir[filepath.Dir(path)], file)
+		return nil
+	})
+	return byDir, err
+}
+
+// Existing_calleeFunc returns the function or method a call resolves to, or nil for conversions and builtins
+func Existing_calleeFunc(info *types.Info, call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.IndexExpr: // generic instantiation f[T](...)
+		if id, ok := fun.X.(*ast.Ident); ok {
+			ident = id
+		}
+	}
+	if ident == nil {
+		return nil
+	}
+	fn, _ := info.Uses[ident].(*types.Func)
+	return fn
+}
+
+// Existing_implementations returns the named types whose value or pointer implements iface
+func Existing_implementations(named []*types.Named, iface types.Type) []*types.Named {
+	it, ok := iface.Underlying().(*types.Interface)
+	if !ok {
+		return nil
+	}
+	var impls []*types.Named
+	for _, n := range named {
+		if types.Implements(n, it) || types.Implements(types.NewPointer(n), it) {
+			impls = append(impls, n)
+		}
+	}
+	return impls
+}
+
+// Existing_typesFuncKey returns the funcKey of a type-checked function or method
+func Existing_typesFuncKey(fn *types.Func) funcKey {
+	key := funcKey{Name: fn.Name()}
+	if fn.Pkg() != nil {
+		key.PkgPath = fn.Pkg().Path()
+	}
+	if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+		t := types.Unalias(recv.Type())
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = types.Unalias(ptr.Elem())
+		}
+		if n, ok := t.(*types.Named); ok {
+			key.Recv = n.Obj().Name()
+		}
+	}
+	return key
+}
+
+// Existing_funcKeys returns the funcKey of a scanned function under root. The package path is
+// the module path plus the file's directory, the same path go/types gives the package.
+func Existing_funcKeys(root string) func(FunctionInfo) funcKey {
+	modRoot, ok := findModuleRoot(root)
+	modPath := ""
+	if ok {
+		modPath = readModulePath(filepath.Join(modRoot, "go.mod"))
+	} else {
+		modRoot = root
+	}
+	return func(fn FunctionInfo) funcKey {
+		pkgPath := modPath
+		if rel := Existing_relSlash(modRoot, filepath.Join(root, filepath.FromSlash(path.Dir(fn.File)))); rel != "." {
+			pkgPath = strings.TrimPrefix(pkgPath+"/"+rel, "/")
+		}
+		return funcKey{PkgPath: pkgPath, Recv: fn.Receiver, Name: fn.Name}
+	}
+}
+
+// typedBuildContext is the build context -typed resolves and filters files with: the default
+// context for this platform, rooted at the module so `go list` finds third-party imports.
+// cgo is off so no package needs the cgo tool; "C" imports are faked when type-checking.
+func typedBuildContext(modRoot string) *build.Context {
+	ctxt := build.Default
+	ctxt.Dir = modRoot
+	ctxt.CgoEnabled = false
+	return &ctxt
+}
+
+// typedImporter type-checks imports from source. Module packages use the files parsed by
+// Existing_parsePackageDirs with full bodies and recorded uses; everything else is checked
+// without function bodies, only for its declarations. Each package is checked once.
+type typedImporter struct {
+	ctxt    *build.Context
+	fset    *token.FileSet
+	modRoot string
+	modPath string
+	byDir   map[string][]*ast.File
+	checked map[string]*typedPackage // by directory; nil Pkg while still being checked
+	sizes   types.Sizes
+}
+
+func newTypedImporter(fset *token.FileSet, modRoot, modPath string, byDir map[string][]*ast.File) *typedImporter {
+	ctxt := typedBuildContext(modRoot)
+	return &typedImporter{
+		ctxt:    ctxt,
+		fset:    fset,
+		modRoot: modRoot,
+		modPath: modPath,
+		byDir:   byDir,
+		checked: make(map[string]*typedPackage),
+		sizes:   types.SizesFor("gc", ctxt.GOARCH),
+	}
+}
+
+func (imp *typedImporter) Import(path string) (*types.Package, error) {
+	return imp.ImportFrom(path, imp.modRoot, 0)
+}
+
+func (imp *typedImporter) ImportFrom(path, srcDir string, _ types.ImportMode) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	if path == imp.modPath || strings.HasPrefix(path, imp.modPath+"/") {
+		dir := filepath.Join(imp.modRoot, filepath.FromSlash(strings.TrimPrefix(path[len(imp.modPath):], "/")))
+		if _, ok := imp.byDir[dir]; ok {
+			p, err := imp.checkModuleDir(dir)
+			if err != nil {
+				return nil, err
+			}
+			return p.Pkg, nil
+		}
+	}
+
+	bp, err := imp.ctxt.Import(path, srcDir, 0)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := imp.checked[bp.Dir]; ok {
+		if p.Pkg == nil {
+			return nil, fmt.Errorf("import cycle through %s", bp.ImportPath)
+		}
+		return p.Pkg, nil
+	}
+	imp.checked[bp.Dir] = &typedPackage{}
+	var files []*ast.File
+	for _, name := range bp.GoFiles {
+		file, err := parser.ParseFile(imp.fset, filepath.Join(bp.Dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	conf := types.Config{Importer: imp, IgnoreFuncBodies: true, FakeImportC: true, Sizes: imp.sizes}
+	pkg, err := conf.Check(bp.ImportPath, imp.fset, files, nil)
+	if err != nil {
+		return nil, fmt.Errorf("type-check %s: %w", bp.ImportPath, err)
+	}
+	imp.checked[bp.Dir] = &typedPackage{Files: files, Pkg: pkg}
+	return pkg, nil
+}
+
+// checkModuleDir type-checks the module package in dir, recording the uses and definitions
+func (imp *typedImporter) checkModuleDir(dir string) (*typedPackage, error) {
+	importPath := imp.modPath
+	if rel := Existing_relSlash(imp.modRoot, dir); rel != "." {
+		importPath += "/" + rel
+	}
+	if p, ok := imp.checked[dir]; ok {
+		if p.Pkg == nil {
+			return nil, fmt.Errorf("import cycle through %s", importPath)
+		}
+		return p, nil
+	}
+	imp.checked[dir] = &typedPackage{}
+	info := &types.Info{Uses: make(map[*ast.Ident]types.Object), Defs: make(map[*ast.Ident]types.Object)}
+	conf := types.Config{Importer: imp, FakeImportC: true, Sizes: imp.sizes}
+	pkg, err := conf.Check(importPath, imp.fset, imp.byDir[dir], info)
+	if err != nil {
+		return nil, fmt.Errorf("type-check %s: %w", importPath, err)
+	}
+	p := &typedPackage{Files: imp.byDir[dir], Pkg: pkg, Info: info}
+	imp.checked[dir] = p
+	return p, nil
+}
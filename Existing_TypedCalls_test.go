@@ -0,0 +1,130 @@
+//go:build flowcharts
+
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+package main
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// An interface called in its own package and implemented in another; report.Unit in the method
+// signature only matches when both packages are checked into one types universe
+func TestTypedCallEdgesAcrossPackages(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":          "module example.com/shapes\n\ngo 1.22\n",
+		"report/total.go": "package report\n\nimport \"fmt\"\n\ntype Unit int\n\ntype Shape interface{ Area() Unit }\n\nfunc Total(s Shape) string { return fmt.Sprint(s.Area()) }\n",
+		"square/sq.go":    "package square\n\nimport \"example.com/shapes/report\"\n\ntype Square struct{ Side report.Unit }\n\nfunc (s Square) Area() report.Unit { return s.Side * s.Side }\n",
+		"main.go":         "package main\n\nimport (\n\t\"example.com/shapes/report\"\n\t\"example.com/shapes/square\"\n)\n\nfunc main() { println(report.Total(square.Square{Side: 2})) }\n",
+	}
+	writeTypedModule(t, root, files)
+
+	defaultDir := build.Default.Dir
+	edges, err := Existing_typedCallEdges(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if build.Default.Dir != defaultDir {
+		t.Errorf("build.Default.Dir changed to %q", build.Default.Dir)
+	}
+	main := funcKey{PkgPath: "example.com/shapes", Name: "main"}
+	total := funcKey{PkgPath: "example.com/shapes/report", Name: "Total"}
+	area := funcKey{PkgPath: "example.com/shapes/square", Recv: "Square", Name: "Area"}
+	want := map[callEdge]bool{{main, total}: true, {total, area}: true}
+	for _, edge := range edges {
+		delete(want, edge)
+	}
+	if len(want) > 0 {
+		t.Errorf("edges %v are missing %v", edges, want)
+	}
+}
+
+// Methods and functions sharing a name stay apart: (*UserStore).Get and (*MovieStore).Get,
+// users.New and movies.New, Get and get are five nodes with their own edges
+func TestTypedCallEdgesKeepSameNamesApart(t *testing.T) {
+	root := t.TempDir()
+	writeTypedModule(t, root, map[string]string{
+		"go.mod":                    "module example.com/flix\n\ngo 1.22\n",
+		"internal/users/users.go":   "package users\n\ntype UserStore struct{}\n\nfunc New() *UserStore { return &UserStore{} }\n\nfunc (s *UserStore) Get() string { return get() }\n\nfunc get() string { return \"user\" }\n",
+		"internal/movies/movies.go": "package movies\n\ntype MovieStore struct{}\n\nfunc New() *MovieStore { return &MovieStore{} }\n\nfunc (s *MovieStore) Get() string { return \"movie\" }\n",
+		"Ex11.go":                   "package main\n\nimport (\n\t\"example.com/flix/internal/movies\"\n\t\"example.com/flix/internal/users\"\n)\n\nfunc main() { println(users.New().Get(), movies.New().Get()) }\n",
+	})
+
+	edges, err := Existing_typedCallEdges(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	main := funcKey{PkgPath: "example.com/flix", Name: "main"}
+	userGet := funcKey{PkgPath: "example.com/flix/internal/users", Recv: "UserStore", Name: "Get"}
+	want := map[callEdge]bool{
+		{main, funcKey{PkgPath: "example.com/flix/internal/users", Name: "New"}}:  true,
+		{main, funcKey{PkgPath: "example.com/flix/internal/movies", Name: "New"}}: true,
+		{main, userGet}: true,
+		{main, funcKey{PkgPath: "example.com/flix/internal/movies", Recv: "MovieStore", Name: "Get"}}: true,
+		{userGet, funcKey{PkgPath: "example.com/flix/internal/users", Name: "get"}}:                   true,
+	}
+	for _, edge := range edges {
+		delete(want, edge)
+	}
+	if len(want) > 0 {
+		t.Errorf("edges %v are missing %v", edges, want)
+	}
+
+	// The scanner's keys must match, or the diagram drops the typed edges
+	structure, err := Existing_scanProject(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyOf := Existing_funcKeys(root)
+	keys := make(map[funcKey]bool)
+	for _, fn := range structure.Functions {
+		keys[keyOf(fn)] = true
+	}
+	for _, edge := range edges {
+		if !keys[edge.From] || !keys[edge.To] {
+			t.Errorf("edge %v has no matching scanned function in %v", edge, keys)
+		}
+	}
+
+	typedMode = true
+	defer func() { typedMode = false }()
+	outDir := t.TempDir()
+	if err := Existing_WriteFunctionDependencyDiagram(outDir, structure, 2); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(outDir, "Existing_function_dependencies_full.mmd.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"main --> UserStore_Get\n", "main --> MovieStore_Get\n", "main --> New\n", "main --> New_2\n", "UserStore_Get --> get\n"} {
+		if !strings.Contains(string(data), line) {
+			t.Errorf("diagram is missing %q:\n%s", line, data)
+		}
+	}
+}
+
+// writeTypedModule writes files, keyed by slash path, under root
+func writeTypedModule(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
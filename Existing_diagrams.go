@@ -32,9 +32,13 @@ EXISTING OBJECTIVES:
 
 FEATURES:
 - Existing_function_inventory.md - Current function inventory
+  (with -split-inventory: inventory_index.md + inventory_<pkg>.md per package)
 - Existing_dynamic_development_sequence.mmd.md - Current development sequence
 - Existing_project_status_report.md - Current project statistics
 - Existing_current_application_brain.mmd.md - Current application brain
+- Existing_package_mindmap.mmd.md - Packages as a mindmap (Mermaid 9.4+)
+- Existing_function_changelog.md - Functions added/removed across runs
+- Existing_concurrency.mmd.md - Functions using goroutines/channels
 
 ===============================================================================
 */
@@ -42,38 +46,143 @@ FEATURES:
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/printer"
 	"go/token"
+	"go/types"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
+// splitInventory writes one inventory file per package instead of one monolith (set from -split-inventory)
+var splitInventory bool
+
 // FunctionInfo represents a discovered function
 type FunctionInfo struct {
 	Name     string
 	File     string
 	Package  string
 	Line     int
+	Lines    int // length of the declaration, signature to closing brace, doc comment excluded
 	IsMethod bool
 	Receiver string
 	Purpose  string
+	// PurposeSource says where Purpose came from: "doc" (doc comment),
+	// "heuristic" (guessed from the name) or "unknown" (no guess)
+	PurposeSource   string
+	Concurrent      bool     // body spawns goroutines or uses channels
+	Workers         []string // long-running goroutines started in the body, e.g. "worker.Run()"
+	Signature       string   // e.g. func (s *Store) Get(id int) (*User, error)
+	Doc             string   // first sentence of the doc comment, if any
+	TODOs           int      // TODO/FIXME comments in the body or doc comment
+	Vendored        bool     // declared under vendor/ (only scanned with -include-vendor)
+	Deprecated      bool     // doc comment has a "Deprecated:" paragraph
+	Init            bool     // package-level func init(): runs at import time, before main
+	DeprecationNote string   // text of that paragraph after "Deprecated:"
 }
 
-// ProjectStructure represents the discovered project structure
+// ProjectStructure represents the discovered project structure.
+// File paths are relative to Root and always use forward slashes so diagrams
+// are identical on Windows and Linux.
 type ProjectStructure struct {
+	Root      string
 	Functions []FunctionInfo
+	Types     []TypeInfo
 	Files     []string
 	Packages  map[string][]string
+	Aux       AuxFiles // migrations, docker-compose services and .env keys
+}
+
+// includeVendor also scans vendor/ directories, tagging their functions Vendored (set from -include-vendor)
+var includeVendor bool
+
+// excludeDirs are path.Match globs for directories, relative to the root with forward slashes,
+// that every scan skips (set from the repeatable -exclude-dir)
+var excludeDirs []string
+
+// addExcludeDir validates and records one -exclude-dir pattern
+func addExcludeDir(pattern string) error {
+	pattern = strings.Trim(filepath.ToSlash(strings.TrimSpace(pattern)), "/")
+	if pattern == "" {
+		return errors.New("empty pattern")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("%q: %w", pattern, err)
+	}
+	excludeDirs = append(excludeDirs, pattern)
+	return nil
+}
+
+// Existing_excludedDir reports whether dir matches an -exclude-dir pattern, relative to root
+func Existing_excludedDir(root, dir string) bool {
+	rel := Existing_relSlash(root, dir)
+	for _, pattern := range excludeDirs {
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Existing_skipScanDir reports whether a walk of the project at root skips the directory path
+// (named name): hidden directories, testdata, vendor/ unless -include-vendor, and -exclude-dir
+// matches. The root itself is never skipped. Every project walker uses it, so all reports see
+// the same files as Existing_scanProject.
+func Existing_skipScanDir(root, path, name string) bool {
+	if path == root {
+		return false
+	}
+	return strings.HasPrefix(name, ".") || name == "testdata" || (name == "vendor" && !includeVendor) || Existing_excludedDir(root, path)
+}
+
+// Existing_ownFunctions returns the project's own functions, leaving out vendored code
+func Existing_ownFunctions(structure *ProjectStructure) []FunctionInfo {
+	own := make([]FunctionInfo, 0, len(structure.Functions))
+	for _, fn := range structure.Functions {
+		if !fn.Vendored {
+			own = append(own, fn)
+		}
+	}
+	return own
+}
+
+// Existing_focusFunctions keeps the functions matching -focus and drops those matching -ignore;
+// a pattern is matched against the file path and against "package.Function"
+func Existing_focusFunctions(functions []FunctionInfo) []FunctionInfo {
+	if focusRegex == nil && ignoreRegex == nil {
+		return functions
+	}
+	matches := func(re *regexp.Regexp, fn FunctionInfo) bool {
+		return re.MatchString(fn.File) || re.MatchString(fn.Package+"."+fn.Name)
+	}
+	var kept []FunctionInfo
+	for _, fn := range functions {
+		if (focusRegex != nil && !matches(focusRegex, fn)) || (ignoreRegex != nil && matches(ignoreRegex, fn)) {
+			continue
+		}
+		kept = append(kept, fn)
+	}
+	return kept
 }
 
 // Existing_scanProject scans the project directory for Go files and extracts function information
 func Existing_scanProject(rootDir string) (*ProjectStructure, error) {
 
 	structure := &ProjectStructure{
+		Root:      rootDir,
 		Functions: []FunctionInfo{},
 		Files:     []string{},
 		Packages:  make(map[string][]string),
@@ -84,13 +193,9 @@ func Existing_scanProject(rootDir string) (*ProjectStructure, error) {
 			return err
 		}
 
-		// Skip hidden directories and vendor
+		// Skip hidden directories, testdata and vendor
 		if info.IsDir() {
-			// Don't skip the root directory itself
-			if path == rootDir {
-				return nil
-			}
-			if strings.HasPrefix(info.Name(), ".") || info.Name() == "vendor" {
+			if Existing_skipScanDir(rootDir, path, info.Name()) {
 				return filepath.SkipDir
 			}
 			return nil
@@ -114,44 +219,72 @@ func Existing_scanProject(rootDir string) (*ProjectStructure, error) {
 		}
 		//NOTE: the migrations folder is not included in the main application, but it is included in the project
 		// so we need to include it in the main application
-		// Only include main application files: Ex11.go and internal\, database\, migrations\ folders (Windows paths)
-		if !strings.Contains(path, "Ex11.go") &&
-			!strings.Contains(path, "internal\\") &&
-			!strings.Contains(path, "migrations\\") &&
-			!strings.Contains(path, "database\\") {
+		// Only include main application files: Ex11.go and internal/, database/, migrations/ folders
+		// (compared with forward slashes so the filter works on Windows and Linux alike)
+		// (vendored packages keep their own layout, so -include-vendor takes all of vendor/)
+		slashPath := filepath.ToSlash(path)
+		vendored := strings.HasPrefix(Existing_relSlash(rootDir, path), "vendor/") || strings.Contains(slashPath, "/vendor/")
+		if !vendored &&
+			!strings.Contains(slashPath, "Ex11.go") &&
+			!strings.Contains(slashPath, "internal/") &&
+			!strings.Contains(slashPath, "migrations/") &&
+			!strings.Contains(slashPath, "database/") {
 			return nil
 		}
 
-		// Extract functions from this file
-		functions, err := Existing_extractFunctions(path)
+		// Extract functions and types from this file
+		functions, types, err := Existing_extractFunctions(path)
 		if err != nil {
 			return err
 		}
 
+		// Record paths relative to the root with forward slashes for the diagrams
+		relPath := Existing_relSlash(rootDir, path)
+		for i := range functions {
+			functions[i].File = relPath
+			functions[i].Vendored = vendored
+		}
+		for i := range types {
+			types[i].File = relPath
+		}
+		structure.Types = append(structure.Types, types...)
+
 		structure.Functions = append(structure.Functions, functions...)
-		structure.Files = append(structure.Files, path)
+		structure.Files = append(structure.Files, relPath)
 
 		// Group by package
 		if len(functions) > 0 {
 			pkg := functions[0].Package
-			structure.Packages[pkg] = append(structure.Packages[pkg], path)
+			structure.Packages[pkg] = append(structure.Packages[pkg], relPath)
 		}
 
 		return nil
 	})
+	if err != nil {
+		return structure, err
+	}
+
+	structure.Aux = scanAuxiliary(rootDir)
+	return structure, nil
+}
 
-	return structure, err
+// Existing_relSlash returns path relative to root with forward slashes (path itself if it is not under root)
+func Existing_relSlash(root, path string) string {
+	if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+		path = rel
+	}
+	return filepath.ToSlash(path)
 }
 
-// Existing_extractFunctions extracts function information from a Go file
-func Existing_extractFunctions(filePath string) ([]FunctionInfo, error) {
+// Existing_extractFunctions extracts function and type information from a Go file
+func Existing_extractFunctions(filePath string) ([]FunctionInfo, []TypeInfo, error) {
 	var functions []FunctionInfo
 
 	// Parse the file
 	fset := token.NewFileSet()
 	node, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Extract package name
@@ -165,54 +298,217 @@ func Existing_extractFunctions(filePath string) ([]FunctionInfo, error) {
 			// This gives a complete picture of the project structure
 
 			funcInfo := FunctionInfo{
-				Name:     x.Name.Name,
-				File:     filePath,
-				Package:  packageName,
-				Line:     fset.Position(x.Pos()).Line,
-				IsMethod: x.Recv != nil,
-				Purpose:  Existing_getSimplePurpose(FunctionInfo{Name: x.Name.Name, File: filePath}),
+				Name:      x.Name.Name,
+				File:      filePath,
+				Package:   packageName,
+				Line:      fset.Position(x.Pos()).Line,
+				Lines:     fset.Position(x.End()).Line - fset.Position(x.Pos()).Line + 1,
+				IsMethod:  x.Recv != nil,
+				Init:      x.Recv == nil && x.Name.Name == "init",
+				Signature: Existing_funcSignature(fset, x),
+				Doc:       Existing_docSummary(x.Doc),
 			}
+			funcInfo.Purpose, funcInfo.PurposeSource = Existing_purposeFor(funcInfo)
+			funcInfo.Deprecated, funcInfo.DeprecationNote = Existing_deprecation(x.Doc)
 
 			// Extract receiver for methods
 			if x.Recv != nil && len(x.Recv.List) > 0 {
-				if ident, ok := x.Recv.List[0].Type.(*ast.Ident); ok {
+				recvType := x.Recv.List[0].Type
+				// Unwrap pointer receivers (func (s *Store) ...)
+				if star, ok := recvType.(*ast.StarExpr); ok {
+					recvType = star.X
+				}
+				// and the type parameters of generic ones (func (l *List[T]) ...)
+				switch generic := recvType.(type) {
+				case *ast.IndexExpr:
+					recvType = generic.X
+				case *ast.IndexListExpr:
+					recvType = generic.X
+				}
+				if ident, ok := recvType.(*ast.Ident); ok {
 					funcInfo.Receiver = ident.Name
 				}
 			}
 
+			// Flag goroutines and channel operations for the concurrency diagram
+			if x.Body != nil {
+				funcInfo.Concurrent = Existing_usesConcurrency(x.Body)
+				funcInfo.Workers = Existing_backgroundWorkers(x.Body)
+			}
+
+			// Count outstanding TODO/FIXME notes, doc comment included
+			start := x.Pos()
+			if x.Doc != nil {
+				start = x.Doc.Pos()
+			}
+			funcInfo.TODOs = Existing_countTODOs(node.Comments, start, x.End())
+
 			functions = append(functions, funcInfo)
 		}
 		return true
 	})
 
-	return functions, nil
+	return functions, Existing_extractTypes(fset, node), nil
 }
 
-// Existing_generateUpdatedReports generates updated flowcharts and documentation
-func Existing_generateUpdatedReports(outDir string, structure *ProjectStructure) error {
-	// Generate function inventory
-	if err := Existing_generateFunctionInventory(outDir, structure); err != nil {
-		return err
+// Existing_funcSignature prints a function declaration without its body or doc comment
+func Existing_funcSignature(fset *token.FileSet, decl *ast.FuncDecl) string {
+	header := *decl
+	header.Body, header.Doc = nil, nil
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, &header); err != nil {
+		return "func " + decl.Name.Name + "(...)"
 	}
+	return buf.String()
+}
 
-	// Generate updated development sequence
-	if err := Existing_generateDynamicDevelopmentSequence(outDir, structure); err != nil {
-		return err
+// Existing_docSummary returns the first sentence of a doc comment ("" without one);
+// later paragraphs such as "Deprecated:" never leak into it
+func Existing_docSummary(doc *ast.CommentGroup) string {
+	first, _, _ := strings.Cut(doc.Text(), "\n\n")
+	text := strings.Join(strings.Fields(first), " ")
+	if i := strings.Index(text, ". "); i >= 0 {
+		text = text[:i+1]
 	}
+	return text
+}
 
-	// Generate project status report
-	if err := Existing_generateProjectStatusReport(outDir, structure); err != nil {
-		return err
+// Existing_deprecation reports whether a doc comment has a paragraph starting with "Deprecated:"
+// (the Go convention) and returns the rest of that paragraph as the note
+func Existing_deprecation(doc *ast.CommentGroup) (bool, string) {
+	for _, paragraph := range strings.Split(doc.Text(), "\n\n") {
+		if note, ok := strings.CutPrefix(strings.TrimSpace(paragraph), "Deprecated:"); ok {
+			return true, strings.Join(strings.Fields(note), " ")
+		}
 	}
+	return false, ""
+}
 
-	// Generate application brain diagram
-	if err := Existing_WriteApplicationBrainDiagram(outDir, structure); err != nil {
-		return err
+// Existing_diagramName returns the node label name of a function, struck through when deprecated
+func Existing_diagramName(fn FunctionInfo) string {
+	if fn.Deprecated {
+		return "<s>" + fn.Name + "()</s> 🚫"
 	}
+	return fn.Name + "()"
+}
 
-	// Generate store connections diagram
-	if err := Existing_WriteStoreConnectionsDiagram(outDir, structure); err != nil {
-		return err
+// Existing_countTODOs counts comment lines starting with TODO or FIXME between from and to
+func Existing_countTODOs(comments []*ast.CommentGroup, from, to token.Pos) int {
+	count := 0
+	for _, group := range comments {
+		if group.End() < from || group.Pos() > to {
+			continue
+		}
+		for _, line := range strings.Split(group.Text(), "\n") {
+			line = strings.TrimSpace(line)
+			if strings.HasPrefix(line, "TODO") || strings.HasPrefix(line, "FIXME") {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Existing_todoBadge returns a Mermaid label suffix for functions with outstanding TODO/FIXME notes
+func Existing_todoBadge(fn FunctionInfo) string {
+	if fn.TODOs == 0 {
+		return ""
+	}
+	return fmt.Sprintf("<br/>📝 %d TODO", fn.TODOs)
+}
+
+// Existing_usesConcurrency reports whether a function body starts goroutines or uses channels
+func Existing_usesConcurrency(body *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.GoStmt, *ast.SendStmt, *ast.SelectStmt, *ast.ChanType:
+			found = true
+		case *ast.UnaryExpr:
+			if x.Op == token.ARROW {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// workerMethodNames are the method names that mark a goroutine as a long-running worker
+var workerMethodNames = map[string]bool{"Run": true, "Start": true, "Loop": true}
+
+// Existing_backgroundWorkers lists the go statements in body that start a background worker:
+// a call to a Run/Start/Loop method or function, or a call that is handed a context.Context
+func Existing_backgroundWorkers(body *ast.BlockStmt) []string {
+	var workers []string
+	ast.Inspect(body, func(n ast.Node) bool {
+		stmt, ok := n.(*ast.GoStmt)
+		if !ok {
+			return true
+		}
+		call := stmt.Call
+		name := ""
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			name = fun.Name
+		case *ast.SelectorExpr:
+			name = fun.Sel.Name
+		case *ast.FuncLit:
+			// go func(ctx context.Context) { ... }(ctx)
+			for _, param := range fun.Type.Params.List {
+				if types.ExprString(param.Type) == "context.Context" {
+					workers = append(workers, "func(ctx)")
+					break
+				}
+			}
+			return true
+		}
+		isWorker := workerMethodNames[name]
+		for _, arg := range call.Args {
+			if expr := types.ExprString(arg); expr == "ctx" || strings.HasPrefix(expr, "context.") {
+				isWorker = true
+			}
+		}
+		if isWorker {
+			workers = append(workers, types.ExprString(call.Fun)+"()")
+		}
+		return true
+	})
+	return workers
+}
+
+// Existing_generateUpdatedReports generates updated flowcharts and documentation
+func Existing_generateUpdatedReports(outDir string, structure *ProjectStructure) error {
+	reports := []struct {
+		name  string
+		write func(string, *ProjectStructure) error
+	}{
+		{"function inventory", Existing_generateFunctionInventory},
+		{"development sequence", Existing_generateDynamicDevelopmentSequence},
+		{"project status report", Existing_generateProjectStatusReport},
+		{"application brain", Existing_WriteApplicationBrainDiagram},
+		{"store connections", Existing_WriteStoreConnectionsDiagram},
+		{"package mindmap", Existing_WritePackageMindmap},
+		{"concurrency diagram", Existing_WriteConcurrencyDiagram},
+		{"per-file function order", Existing_WritePerFileFunctionDiagrams},
+		{"type report", Existing_WriteTypeReport},
+		{"long functions", Existing_WriteLongFunctionsReport},
+		{"package readmes", Existing_WritePackageReadmes},
+		{"architecture svg", Existing_WriteArchitectureSVG},
+		{"package treemap", Existing_WritePackageTreemap},
+		{"dependency matrix", Existing_WriteDependencyMatrix},
+		{"import cycles", Existing_WriteImportCyclesReport},
+		// Append functions added/removed since the last run
+		{"function changelog", Existing_AppendInventoryChangelog},
+	}
+
+	for _, report := range reports {
+		stop := profileStep(report.name)
+		err := report.write(outDir, structure)
+		stop()
+		if err != nil {
+			return fmt.Errorf("%s: %w", report.name, err)
+		}
 	}
 
 	return nil
@@ -220,51 +516,184 @@ func Existing_generateUpdatedReports(outDir string, structure *ProjectStructure)
 
 // Existing_generateFunctionInventory creates a comprehensive inventory of all functions
 func Existing_generateFunctionInventory(outDir string, structure *ProjectStructure) error {
+	if splitInventory {
+		return Existing_generateSplitInventory(outDir, structure)
+	}
+
 	var content strings.Builder
 
-	content.WriteString("# Existing Function Inventory - Auto-Generated\n\n")
-	content.WriteString("This document provides a comprehensive inventory of all functions currently existing in the project.\n\n")
+	content.WriteString(tr("report.inventory.title") + "\n\n")
+	content.WriteString(tr("report.inventory.intro") + "\n\n")
 
 	// Group functions by package
 	packageGroups := Existing_categorizeFunctions(structure.Functions)
 
-	for pkg, functions := range packageGroups {
-		content.WriteString(fmt.Sprintf("## Package: %s\n\n", pkg))
-		content.WriteString(fmt.Sprintf("**Files:** %d  |  **Functions:** %d\n\n", len(structure.Packages[pkg]), len(functions)))
+	for _, pkg := range Existing_sortedKeys(packageGroups) {
+		Existing_writeInventoryPackage(&content, pkg, packageGroups[pkg], len(structure.Packages[pkg]))
+	}
+
+	Existing_writeInventoryDeprecated(&content, structure)
+	Existing_writeInventorySummary(&content, structure)
+
+	path := filepath.Join(outDir, "Existing_function_inventory.md")
+	return os.WriteFile(path, []byte(content.String()), 0644)
+}
+
+// Existing_generateSplitInventory writes one inventory_<pkg>.md per package plus inventory_index.md
+func Existing_generateSplitInventory(outDir string, structure *ProjectStructure) error {
+	packageGroups := Existing_categorizeFunctions(structure.Functions)
+
+	var index strings.Builder
+	index.WriteString(tr("report.inventory.title") + "\n\n")
+	index.WriteString(tr("report.inventory.intro") + "\n\n")
+	index.WriteString(fmt.Sprintf("| %s | %s | %s |\n", tr("report.inventory.package"), tr("report.inventory.files"), tr("report.inventory.functions")))
+	index.WriteString("|---|---|---|\n")
+
+	for _, pkg := range Existing_sortedKeys(packageGroups) {
+		functions := packageGroups[pkg]
+		fileName := "inventory_" + Existing_safeFileName(pkg) + ".md"
+
+		var content strings.Builder
+		content.WriteString(fmt.Sprintf("%s - %s\n\n", tr("report.inventory.title"), pkg))
+		content.WriteString("[← Index](inventory_index.md)\n\n")
+		Existing_writeInventoryPackage(&content, pkg, functions, len(structure.Packages[pkg]))
+		if err := os.WriteFile(filepath.Join(outDir, fileName), []byte(content.String()), 0644); err != nil {
+			return err
+		}
+
+		index.WriteString(fmt.Sprintf("| [%s](%s) | %d | %d |\n", pkg, fileName, len(structure.Packages[pkg]), len(functions)))
+	}
+	index.WriteString("\n")
+
+	Existing_writeInventoryDeprecated(&index, structure)
+	Existing_writeInventorySummary(&index, structure)
+
+	path := filepath.Join(outDir, "inventory_index.md")
+	return os.WriteFile(path, []byte(index.String()), 0644)
+}
 
-		// Sort functions by name
-		sort.Slice(functions, func(i, j int) bool {
-			return functions[i].Name < functions[j].Name
-		})
+// Existing_writeInventoryPackage writes the inventory section of one package
+func Existing_writeInventoryPackage(content *strings.Builder, pkg string, functions []FunctionInfo, fileCount int) {
+	content.WriteString(fmt.Sprintf("## %s: %s\n\n", tr("report.inventory.package"), pkg))
+	content.WriteString(fmt.Sprintf("**%s:** %d  |  **%s:** %d\n\n", tr("report.inventory.files"), fileCount, tr("report.inventory.functions"), len(functions)))
 
-		for _, fn := range functions {
-			content.WriteString(fmt.Sprintf("- **%s**", fn.Name))
-			if fn.IsMethod {
-				content.WriteString(fmt.Sprintf(" (method on %s)", fn.Receiver))
+	// Sort functions by name
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+
+	for _, fn := range functions {
+		content.WriteString(fmt.Sprintf("- **%s**", fn.Name))
+		if fn.IsMethod {
+			content.WriteString(fmt.Sprintf(" (%s %s)", tr("report.inventory.methodOn"), fn.Receiver))
+		}
+		if Existing_purposeRank(fn.PurposeSource) >= Existing_purposeRank(minPurposeConfidence) {
+			if fn.PurposeSource == purposeDoc {
+				content.WriteString(fmt.Sprintf(" - %s", fn.Purpose))
+			} else {
+				content.WriteString(fmt.Sprintf(" - *%s* %s", fn.Purpose, tr("report.inventory.inferred")))
 			}
-			content.WriteString(fmt.Sprintf(" - %s\n", fn.Purpose))
-			content.WriteString(fmt.Sprintf("  - File: `%s` (line %d)\n", fn.File, fn.Line))
+		}
+		if fn.TODOs > 0 {
+			content.WriteString(fmt.Sprintf(" 📝 %d TODO/FIXME", fn.TODOs))
+		}
+		if fn.Deprecated {
+			content.WriteString(" " + tr("report.inventory.deprecated"))
 		}
 		content.WriteString("\n")
+		content.WriteString(fmt.Sprintf("  - %s: `%s` (%s %d)\n", tr("report.inventory.file"), fn.File, tr("report.inventory.line"), fn.Line))
 	}
+	content.WriteString("\n")
+}
 
-	content.WriteString(fmt.Sprintf("## Summary\n\n"))
-	content.WriteString(fmt.Sprintf("- **Total Functions:** %d\n", len(structure.Functions)))
-	content.WriteString(fmt.Sprintf("- **Total Files:** %d\n", len(structure.Files)))
-	content.WriteString(fmt.Sprintf("- **Total Packages:** %d\n", len(structure.Packages)))
+// Existing_writeInventoryDeprecated lists the deprecated functions with their notes (nothing when there are none)
+func Existing_writeInventoryDeprecated(content *strings.Builder, structure *ProjectStructure) {
+	var deprecated []FunctionInfo
+	for _, fn := range structure.Functions {
+		if fn.Deprecated {
+			deprecated = append(deprecated, fn)
+		}
+	}
+	if len(deprecated) == 0 {
+		return
+	}
+	sort.Slice(deprecated, func(i, j int) bool {
+		if deprecated[i].File != deprecated[j].File {
+			return deprecated[i].File < deprecated[j].File
+		}
+		return deprecated[i].Line < deprecated[j].Line
+	})
+	content.WriteString(fmt.Sprintf("## %s (%d)\n\n", tr("report.deprecated"), len(deprecated)))
+	content.WriteString(tr("report.deprecated.intro") + "\n\n")
+	for _, fn := range deprecated {
+		note := fn.DeprecationNote
+		if note == "" {
+			note = "-"
+		}
+		content.WriteString(fmt.Sprintf("- ~~**%s**~~ `%s:%d` - %s\n", fn.Name, fn.File, fn.Line, note))
+	}
+	content.WriteString("\n")
+}
 
-	path := filepath.Join(outDir, "Existing_function_inventory.md")
-	return os.WriteFile(path, []byte(content.String()), 0644)
+// Existing_writeInventorySummary writes the totals at the end of an inventory
+func Existing_writeInventorySummary(content *strings.Builder, structure *ProjectStructure) {
+	content.WriteString(fmt.Sprintf("## %s\n\n", tr("report.summary")))
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalFunctions"), len(structure.Functions)))
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalFiles"), len(structure.Files)))
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalPackages"), len(structure.Packages)))
+
+	// Technical debt: TODO/FIXME notes per file, most first
+	perFile := make(map[string]int)
+	total := 0
+	for _, fn := range structure.Functions {
+		if fn.TODOs > 0 {
+			perFile[fn.File] += fn.TODOs
+			total += fn.TODOs
+		}
+	}
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalTODOs"), total))
+	if total == 0 {
+		return
+	}
+	files := make([]string, 0, len(perFile))
+	for file := range perFile {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if perFile[files[i]] != perFile[files[j]] {
+			return perFile[files[i]] > perFile[files[j]]
+		}
+		return files[i] < files[j]
+	})
+	content.WriteString(fmt.Sprintf("\n### %s\n\n", tr("report.todoFiles")))
+	for _, file := range files {
+		content.WriteString(fmt.Sprintf("- `%s`: %d\n", file, perFile[file]))
+	}
 }
 
-// Existing_generateDynamicDevelopmentSequence creates an updated development sequence based on discovered functions
-func Existing_generateDynamicDevelopmentSequence(outDir string, structure *ProjectStructure) error {
-	content := `# Existing Dynamic Development Sequence - Auto-Generated
+// Existing_sortedKeys returns the package names of a function grouping in order
+func Existing_sortedKeys(groups map[string][]FunctionInfo) []string {
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
 
-This diagram shows the **order in which functions should be created** based on the current project structure.
-Understanding this helps you know **where to start** when building similar projects.
+// Existing_safeFileName keeps letters, digits, '-' and '_' so a name can be used in a file name
+func Existing_safeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
 
-` + "```mermaid\n" + `
+// Existing_generateDynamicDevelopmentSequence creates an updated development sequence based on discovered functions
+func Existing_generateDynamicDevelopmentSequence(outDir string, structure *ProjectStructure) error {
+	content := tr("report.sequence.title") + "\n\n" + tr("report.sequence.intro") + "\n\n" + "```mermaid\n" + `
 flowchart TD
 `
 
@@ -281,7 +710,7 @@ flowchart TD
 	phaseNum := 1
 	for _, phase := range phases {
 		if functions, exists := phaseGroups[phase]; exists {
-			content += fmt.Sprintf("    subgraph Phase%d[\"🚀 PHASE %d: %s\"]\n", phaseNum, phaseNum, phase)
+			content += fmt.Sprintf("    subgraph Phase%d[\"🚀 %s %d: %s\"]\n", phaseNum, tr("report.sequence.phase"), phaseNum, trPhase(phase))
 			for i, fn := range functions {
 				content += fmt.Sprintf("        F%d[\"%d. %s<br/>📍 %s<br/>🎯 %s\"]\n",
 					phaseNum*100+i, phaseNum*100+i, fn.Name, fn.File, fn.Purpose)
@@ -301,28 +730,33 @@ flowchart TD
 func Existing_generateProjectStatusReport(outDir string, structure *ProjectStructure) error {
 	var content strings.Builder
 
-	content.WriteString("# Existing Project Status Report - Auto-Generated\n\n")
-	content.WriteString(fmt.Sprintf("**Generated:** %s\n\n", "2025-09-14"))
+	content.WriteString(tr("report.status.title") + "\n\n")
+	content.WriteString(fmt.Sprintf("**%s:** %s\n\n", tr("report.status.generated"), "2025-09-14"))
 
-	content.WriteString("## 📊 Current Project Statistics\n\n")
-	content.WriteString(fmt.Sprintf("- **Total Functions:** %d\n", len(structure.Functions)))
-	content.WriteString(fmt.Sprintf("- **Total Files:** %d\n", len(structure.Files)))
-	content.WriteString(fmt.Sprintf("- **Total Packages:** %d\n", len(structure.Packages)))
+	content.WriteString(tr("report.status.statistics") + "\n\n")
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalFunctions"), len(structure.Functions)))
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalFiles"), len(structure.Files)))
+	content.WriteString(fmt.Sprintf("- **%s:** %d\n", tr("report.totalPackages"), len(structure.Packages)))
 
-	content.WriteString("\n## 📁 Current Package Breakdown\n\n")
-	for pkg, files := range structure.Packages {
-		content.WriteString(fmt.Sprintf("- **%s:** %d files\n", pkg, len(files)))
+	content.WriteString("\n" + tr("report.status.packages") + "\n\n")
+	pkgs := make([]string, 0, len(structure.Packages))
+	for pkg := range structure.Packages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs) // map order would change the report, and its manifest hash, on every run
+	for _, pkg := range pkgs {
+		content.WriteString(fmt.Sprintf("- **%s:** %d %s\n", pkg, len(structure.Packages[pkg]), tr("unit.files")))
 	}
 
-	content.WriteString("\n## 🎯 Current Development Phases\n\n")
+	content.WriteString("\n" + tr("report.status.phases") + "\n\n")
 	phaseGroups := make(map[string][]FunctionInfo)
 	for _, fn := range structure.Functions {
 		phase := Existing_determinePhase(fn)
 		phaseGroups[phase] = append(phaseGroups[phase], fn)
 	}
 
-	for phase, functions := range phaseGroups {
-		content.WriteString(fmt.Sprintf("- **%s:** %d functions\n", phase, len(functions)))
+	for _, phase := range Existing_sortedKeys(phaseGroups) {
+		content.WriteString(fmt.Sprintf("- **%s:** %d %s\n", trPhase(phase), len(phaseGroups[phase]), tr("unit.functions")))
 	}
 
 	path := filepath.Join(outDir, "Existing_project_status_report.md")
@@ -375,6 +809,65 @@ func Existing_determinePhase(fn FunctionInfo) string {
 	return "Data Layer"
 }
 
+// Purpose sources, from most to least trustworthy
+const (
+	purposeDoc       = "doc"
+	purposeHeuristic = "heuristic"
+	purposeUnknown   = "unknown"
+)
+
+// minPurposeConfidence hides inventory purposes from less trustworthy sources (set from -min-purpose-confidence)
+var minPurposeConfidence = purposeUnknown
+
+// setMinPurposeConfidence validates the -min-purpose-confidence value
+func setMinPurposeConfidence(value string) error {
+	switch value {
+	case purposeDoc, purposeHeuristic, purposeUnknown:
+		minPurposeConfidence = value
+		return nil
+	}
+	return fmt.Errorf("%q is not one of doc, heuristic, unknown", value)
+}
+
+// Existing_purposeRank orders purpose sources: doc > heuristic > unknown
+func Existing_purposeRank(source string) int {
+	switch source {
+	case purposeDoc:
+		return 2
+	case purposeHeuristic:
+		return 1
+	}
+	return 0
+}
+
+// Existing_purposeFor takes the purpose from the doc comment when there is one,
+// otherwise guesses it from the function name
+func Existing_purposeFor(fn FunctionInfo) (string, string) {
+	if fn.Doc != "" {
+		// "NewUserStore creates a user store." -> "Creates a user store"
+		purpose := strings.TrimSuffix(strings.TrimPrefix(fn.Doc, fn.Name+" "), ".")
+		if purpose != "" {
+			first, size := utf8.DecodeRuneInString(purpose)
+			purpose = Existing_truncate(string(unicode.ToUpper(first))+purpose[size:], 80)
+			// Purposes end up inside quoted Mermaid labels
+			return strings.ReplaceAll(purpose, "\"", "'"), purposeDoc
+		}
+	}
+	purpose := Existing_getSimplePurpose(fn)
+	if purpose == "General function" {
+		return purpose, purposeUnknown
+	}
+	return purpose, purposeHeuristic
+}
+
+// Existing_truncate shortens s to at most max runes, ending in "..." when cut
+func Existing_truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max-3]) + "..."
+}
+
 // Existing_getSimplePurpose provides a simple purpose description for a function
 func Existing_getSimplePurpose(fn FunctionInfo) string {
 	name := strings.ToLower(fn.Name)
@@ -413,6 +906,9 @@ func Existing_getSimplePurpose(fn FunctionInfo) string {
 	return "General function"
 }
 
+// newAppDuties is the full-detail description on the brain diagram's NewApplication node
+const newAppDuties = "<br/>🏆 MOST IMPORTANT FUNCTION<br/>- Creates all dependencies<br/>- Orchestrates initialization<br/>- Manages application lifecycle<br/>- Central coordination point"
+
 // Existing_WriteApplicationBrainDiagram creates a brain diagram based on actual discovered functions
 func Existing_WriteApplicationBrainDiagram(outDir string, structure *ProjectStructure) error {
 	content := "```mermaid\n" +
@@ -429,10 +925,12 @@ func Existing_WriteApplicationBrainDiagram(outDir string, structure *ProjectStru
 	}
 
 	if appFunction != nil {
-		content += fmt.Sprintf("        NEWAPP[\"🎯 %s() Function<br/>📍 Location: %s:%d<br/>🏆 MOST IMPORTANT FUNCTION<br/>- Creates all dependencies<br/>- Orchestrates initialization<br/>- Manages application lifecycle<br/>- Central coordination point\"]\n",
-			appFunction.Name, appFunction.File, appFunction.Line)
+		name := fmt.Sprintf("🎯 %s() Function", appFunction.Name)
+		file := fmt.Sprintf("📍 Location: %s:%d", appFunction.File, appFunction.Line)
+		content += fmt.Sprintf("        NEWAPP[\"%s\"]\n", Existing_nodeLabel(name, file, name+"<br/>"+file+newAppDuties))
 	} else {
-		content += "        NEWAPP[\"🎯 NewApplication() Function<br/>📍 Location: internal/app/app.go<br/>🏆 MOST IMPORTANT FUNCTION<br/>- Creates all dependencies<br/>- Orchestrates initialization<br/>- Manages application lifecycle<br/>- Central coordination point\"]\n"
+		name, file := "🎯 NewApplication() Function", "📍 Location: internal/app/app.go"
+		content += fmt.Sprintf("        NEWAPP[\"%s\"]\n", Existing_nodeLabel(name, file, name+"<br/>"+file+newAppDuties))
 	}
 
 	content += "    end\n\n"
@@ -443,16 +941,16 @@ func Existing_WriteApplicationBrainDiagram(outDir string, structure *ProjectStru
 	// Find stores
 	for _, fn := range structure.Functions {
 		if strings.Contains(fn.Name, "Store") && strings.Contains(fn.File, "store") {
-			content += fmt.Sprintf("        %s[\"%s<br/>📍 %s<br/>🎯 %s\"]\n",
-				strings.ToUpper(strings.ReplaceAll(fn.Name, "New", "")), fn.Name, fn.File, fn.Purpose)
+			content += fmt.Sprintf("        %s[\"%s\"]\n", strings.ToUpper(strings.ReplaceAll(fn.Name, "New", "")),
+				Existing_nodeLabel(fn.Name, "📍 "+fn.File, fn.Name+"<br/>📍 "+fn.File+"<br/>🎯 "+fn.Purpose))
 		}
 	}
 
 	// Find handlers
 	for _, fn := range structure.Functions {
 		if strings.Contains(fn.Name, "Handler") && strings.Contains(fn.File, "handler") {
-			content += fmt.Sprintf("        %s[\"%s<br/>📍 %s<br/>🎯 %s\"]\n",
-				strings.ToUpper(strings.ReplaceAll(fn.Name, "New", "")), fn.Name, fn.File, fn.Purpose)
+			content += fmt.Sprintf("        %s[\"%s\"]\n", strings.ToUpper(strings.ReplaceAll(fn.Name, "New", "")),
+				Existing_nodeLabel(fn.Name, "📍 "+fn.File, fn.Name+"<br/>📍 "+fn.File+"<br/>🎯 "+fn.Purpose))
 		}
 	}
 
@@ -462,7 +960,8 @@ func Existing_WriteApplicationBrainDiagram(outDir string, structure *ProjectStru
 	content += "    subgraph Usage[\"📱 HOW THE BRAIN IS USED (Current Project)\"]\n"
 	for _, fn := range structure.Functions {
 		if fn.Name == "main" && strings.Contains(fn.File, "Ex10.go") {
-			content += fmt.Sprintf("        MAIN[\"Ex10.go (main)<br/>📍 %s:%d\"]\n", fn.File, fn.Line)
+			file := fmt.Sprintf("📍 %s:%d", fn.File, fn.Line)
+			content += fmt.Sprintf("        MAIN[\"%s\"]\n", Existing_nodeLabel("Ex10.go (main)", file, "Ex10.go (main)<br/>"+file))
 			break
 		}
 	}
@@ -482,23 +981,48 @@ func Existing_WriteApplicationBrainDiagram(outDir string, structure *ProjectStru
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
+// maxMigrationLabels caps the migration file names listed on the store connections diagram
+const maxMigrationLabels = 8
+
 // Existing_WriteStoreConnectionsDiagram creates a connections diagram based on actual discovered functions
 func Existing_WriteStoreConnectionsDiagram(outDir string, structure *ProjectStructure) error {
 	content := "```mermaid\n" +
 		"flowchart TD\n" +
 		"    subgraph External[\"External Dependencies (Current Project)\"]\n" +
-		"        DB[(PostgreSQL Database)]\n" +
-		"        DOCKER[Docker Container<br/>workoutDB]\n" +
-		"        MIGRATIONS[Migration Files<br/>00001_users.sql<br/>00002_workouts.sql<br/>00003_workout_entries.sql]\n" +
-		"    end\n\n"
+		"        DB[(PostgreSQL Database)]\n"
+
+	// Name the real compose services and migration files found by the auxiliary scan
+	if len(structure.Aux.Services) > 0 {
+		var services []string
+		for _, svc := range structure.Aux.Services {
+			if svc.Image != "" {
+				services = append(services, fmt.Sprintf("%s (%s)", svc.Name, svc.Image))
+			} else {
+				services = append(services, svc.Name)
+			}
+		}
+		content += fmt.Sprintf("        DOCKER[\"Docker Compose<br/>%s\"]\n", Existing_mermaidLabel(strings.Join(services, "<br/>")))
+	}
+	if len(structure.Aux.Migrations) > 0 {
+		var files []string
+		for i, m := range structure.Aux.Migrations {
+			if i == maxMigrationLabels {
+				files = append(files, fmt.Sprintf("... %d more", len(structure.Aux.Migrations)-i))
+				break
+			}
+			files = append(files, filepath.Base(m.File))
+		}
+		content += fmt.Sprintf("        MIGRATIONS[\"Migration Files<br/>%s\"]\n", Existing_mermaidLabel(strings.Join(files, "<br/>")))
+	}
+	content += "    end\n\n"
 
 	// Find actual store files
 	content += "    subgraph StoreLayer[\"Store Layer (Current Project)\"]\n"
 	for _, fn := range structure.Functions {
 		if strings.Contains(fn.File, "store") && !strings.Contains(fn.File, "test") {
 			fileName := filepath.Base(fn.File)
-			content += fmt.Sprintf("        %s[\"%s<br/>🎯 %s<br/>- %s\"]\n",
-				strings.ToUpper(strings.ReplaceAll(fileName, ".go", "")), fileName, fn.Purpose, fn.Name)
+			content += fmt.Sprintf("        %s[\"%s\"]\n", strings.ToUpper(strings.ReplaceAll(fileName, ".go", "")),
+				Existing_nodeLabel(fn.Name, fileName, fileName+"<br/>🎯 "+fn.Purpose+"<br/>- "+fn.Name))
 		}
 	}
 	content += "    end\n\n"
@@ -508,8 +1032,8 @@ func Existing_WriteStoreConnectionsDiagram(outDir string, structure *ProjectStru
 	for _, fn := range structure.Functions {
 		if strings.Contains(fn.File, "handler") {
 			fileName := filepath.Base(fn.File)
-			content += fmt.Sprintf("        %s[\"%s<br/>🎯 %s<br/>- %s\"]\n",
-				strings.ToUpper(strings.ReplaceAll(fileName, ".go", "")), fileName, fn.Purpose, fn.Name)
+			content += fmt.Sprintf("        %s[\"%s\"]\n", strings.ToUpper(strings.ReplaceAll(fileName, ".go", "")),
+				Existing_nodeLabel(fn.Name, fileName, fileName+"<br/>🎯 "+fn.Purpose+"<br/>- "+fn.Name))
 		}
 	}
 	content += "    end\n\n"
@@ -518,7 +1042,8 @@ func Existing_WriteStoreConnectionsDiagram(outDir string, structure *ProjectStru
 	content += "    subgraph MainApp[\"Main App (Current Project)\"]\n"
 	for _, fn := range structure.Functions {
 		if fn.Name == "main" && strings.Contains(fn.File, "Ex11.go") {
-			content += fmt.Sprintf("        EX11[\"Ex11.go<br/>📍 %s:%d<br/>🎯 %s\"]\n", fn.File, fn.Line, fn.Purpose)
+			file := fmt.Sprintf("📍 %s:%d", fn.File, fn.Line)
+			content += fmt.Sprintf("        EX11[\"%s\"]\n", Existing_nodeLabel("Ex11.go", file, "Ex11.go<br/>"+file+"<br/>🎯 "+fn.Purpose))
 			break
 		}
 	}
@@ -529,14 +1054,176 @@ func Existing_WriteStoreConnectionsDiagram(outDir string, structure *ProjectStru
 	content += "    APILayer -->|\"🔴 CRITICAL<br/>Uses store interfaces\"| StoreLayer\n"
 	content += "    EX11 -->|\"Creates and initializes\"| StoreLayer\n"
 	content += "    EX11 -->|\"Creates and initializes\"| APILayer\n"
-	content += "    DOCKER -->|\"Hosts\"| DB\n"
-	content += "    MIGRATIONS -->|\"Creates tables\"| DB\n"
+	if len(structure.Aux.Services) > 0 {
+		content += "    DOCKER -->|\"Hosts\"| DB\n"
+	}
+	if len(structure.Aux.Migrations) > 0 {
+		content += "    MIGRATIONS -->|\"Creates tables\"| DB\n"
+	}
 	content += "```\n"
 
 	path := filepath.Join(outDir, "Existing_store_connections.mmd.md")
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
+// Existing_WritePackageMindmap creates a mindmap rooted at the module with one branch per package
+func Existing_WritePackageMindmap(outDir string, structure *ProjectStructure) error {
+	if !mermaidSupports("mindmap") {
+		fmt.Printf("ℹ️  Skipping package mindmap (needs Mermaid %s+, pinned %s)\n", mermaidFeatureMinVersion["mindmap"], mermaidVersion)
+		return nil
+	}
+
+	// Root the mindmap at the module path when the scanned files belong to a module
+	rootName := "Project"
+	if structure.Root != "" {
+		if modRoot, ok := findModuleRoot(structure.Root); ok {
+			if mod := readModulePath(filepath.Join(modRoot, "go.mod")); mod != "" {
+				rootName = mod
+			}
+		}
+	}
+
+	counts := make(map[string]int)
+	for _, fn := range structure.Functions {
+		counts[fn.Package]++
+	}
+	packages := make([]string, 0, len(structure.Packages))
+	for pkg := range structure.Packages {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+
+	clean := strings.NewReplacer("(", "", ")", "", "[", "", "]", "", "{", "", "}", "")
+	var content strings.Builder
+	content.WriteString("```mermaid\n")
+	content.WriteString("mindmap\n")
+	content.WriteString(fmt.Sprintf("  root((%s))\n", clean.Replace(rootName)))
+	for _, pkg := range packages {
+		content.WriteString(fmt.Sprintf("    📦 %s\n", clean.Replace(pkg)))
+		content.WriteString(fmt.Sprintf("      %d %s\n", counts[pkg], tr("unit.functions")))
+	}
+	content.WriteString("```\n")
+
+	path := filepath.Join(outDir, "Existing_package_mindmap.mmd.md")
+	return os.WriteFile(path, []byte(content.String()), 0644)
+}
+
+// Existing_WritePerFileFunctionDiagrams writes per_file/<file>.mmd.md listing each file's functions
+// top-to-bottom in source order (reading order), plus per_file/index.md linking them
+func Existing_WritePerFileFunctionDiagrams(outDir string, structure *ProjectStructure) error {
+	dir := filepath.Join(outDir, "per_file")
+	if err := ensureDir(dir); err != nil {
+		return err
+	}
+
+	byFile := make(map[string][]FunctionInfo)
+	for _, fn := range structure.Functions {
+		byFile[fn.File] = append(byFile[fn.File], fn)
+	}
+
+	var index strings.Builder
+	index.WriteString("# Per-File Function Order - Auto-Generated\n\n")
+	index.WriteString("One diagram per source file, functions in the order they appear - read them top to bottom.\n\n")
+	index.WriteString("| File | Functions | Diagram |\n")
+	index.WriteString("|------|-----------|---------|\n")
+
+	for _, file := range structure.Files {
+		functions := byFile[file]
+		if len(functions) == 0 {
+			continue
+		}
+		sort.SliceStable(functions, func(i, j int) bool { return functions[i].Line < functions[j].Line })
+
+		var content strings.Builder
+		content.WriteString(fmt.Sprintf("# 📄 %s - Function Order\n\n", file))
+		content.WriteString("[← Index](index.md)\n\n")
+		content.WriteString("```mermaid\n")
+		content.WriteString("flowchart TB\n")
+		for i, fn := range functions {
+			name := fn.Name
+			if fn.Receiver != "" {
+				name = fn.Receiver + "." + fn.Name
+			}
+			content.WriteString(fmt.Sprintf("    F%d[\"%d. %s()<br/>📍 line %d<br/>%s\"]\n", i+1, i+1, Existing_mermaidLabel(name), fn.Line, Existing_mermaidLabel(fn.Purpose)))
+			if i > 0 {
+				content.WriteString(fmt.Sprintf("    F%d --> F%d\n", i, i+1))
+			}
+		}
+		content.WriteString("```\n")
+
+		name := Existing_safeFileName(strings.TrimSuffix(file, ".go")) + ".mmd.md"
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content.String()), 0644); err != nil {
+			return err
+		}
+		index.WriteString(fmt.Sprintf("| `%s` | %d | [%s](%s) |\n", file, len(functions), name, name))
+	}
+
+	return os.WriteFile(filepath.Join(dir, "index.md"), []byte(index.String()), 0644)
+}
+
+// Existing_WriteConcurrencyDiagram marks the functions that start goroutines or use channels
+func Existing_WriteConcurrencyDiagram(outDir string, structure *ProjectStructure) error {
+	byPkg := make(map[string][]FunctionInfo)
+	others := make(map[string]int)
+	total := 0
+	for _, fn := range structure.Functions {
+		if fn.Concurrent {
+			byPkg[fn.Package] = append(byPkg[fn.Package], fn)
+			total++
+		} else {
+			others[fn.Package]++
+		}
+	}
+	packages := make([]string, 0, len(byPkg))
+	for pkg := range byPkg {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+
+	var content strings.Builder
+	content.WriteString("# Existing Concurrency Map - Auto-Generated\n\n")
+	content.WriteString("Functions that start goroutines (`go` statements) or use channels (send, receive, `select`, `chan` types) are highlighted.\n\n")
+	content.WriteString("```mermaid\n")
+	content.WriteString("flowchart LR\n")
+	if total == 0 {
+		content.WriteString("    NONE[\"✅ No goroutines or channel operations found\"]\n")
+	}
+	node := 0
+	for i, pkg := range packages {
+		content.WriteString(fmt.Sprintf("    subgraph PKG%d[\"📦 %s\"]\n", i+1, pkg))
+		for _, fn := range byPkg[pkg] {
+			node++
+			name := fn.Name
+			if fn.Receiver != "" {
+				name = fn.Receiver + "." + fn.Name
+			}
+			content.WriteString(fmt.Sprintf("        C%d[\"🔀 %s()<br/>📍 %s:%d\"]:::concurrent\n", node, name, filepath.Base(fn.File), fn.Line))
+		}
+		if others[pkg] > 0 {
+			content.WriteString(fmt.Sprintf("        S%d[\"%d other %s\"]:::sequential\n", i+1, others[pkg], tr("unit.functions")))
+		}
+		content.WriteString("    end\n")
+	}
+	content.WriteString("    classDef concurrent fill:#ffe0b2,stroke:#e65100,stroke-width:2px\n")
+	content.WriteString("    classDef sequential fill:#eceff1,stroke:#90a4ae\n")
+	content.WriteString("```\n\n")
+	content.WriteString(fmt.Sprintf("**Concurrent functions:** %d of %d\n", total, len(structure.Functions)))
+
+	path := filepath.Join(outDir, "Existing_concurrency.mmd.md")
+	return os.WriteFile(path, []byte(content.String()), 0644)
+}
+
+// architectureGenerator runs Existing_WriteArchitectureDiagram from the generator registry
+type architectureGenerator struct{}
+
+func init() { Register(architectureGenerator{}) }
+
+func (architectureGenerator) Name() string { return "architecture" }
+
+func (architectureGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	return Existing_WriteArchitectureDiagram(cfg.Root, cfg.OutDir)
+}
+
 // Existing_WriteArchitectureDiagram creates an architecture diagram based on the current project structure
 func Existing_WriteArchitectureDiagram(wd, outDir string) error {
 	var b strings.Builder
@@ -553,8 +1240,10 @@ func Existing_WriteArchitectureDiagram(wd, outDir string) error {
 	if fileExists(filepath.Join(wd, "docker-compose.yml")) {
 		b.WriteString("  Docker[/docker-compose.yml/] --> DB\n")
 	}
-	if fileExists(filepath.Join(wd, "migrations")) {
-		b.WriteString("  Goose[/migrations/] --> DB\n")
+	for _, dir := range treeDirs {
+		if strings.Contains(strings.ToLower(dir), "migrations") && dirExists(filepath.Join(wd, filepath.FromSlash(dir))) {
+			b.WriteString("  " + Existing_mermaidID("Goose_"+dir) + "[/" + Existing_mermaidLabel(dir) + "/] --> DB\n")
+		}
 	}
 
 	// Subgraphs for clarity (visible grouping only)
@@ -582,58 +1271,167 @@ func Existing_WriteArchitectureDiagram(wd, outDir string) error {
 	return os.WriteFile(path, []byte(b.String()), 0644)
 }
 
-// Existing_WriteFileTreeDiagram scans common project directories and writes a simple Mermaid tree
-// func Existing_WriteFileTreeDiagram(wd, outDir string) error {
-// 	var b strings.Builder
-// 	b.WriteString("```mermaid\n")
-// 	b.WriteString("graph TD\n")
-// 	b.WriteString("  ROOT[\"Project Root\"]\n")
-
-// 	// List of top-level directories to include
-// 	include := []string{"internal", "migrations", "database", "cmd"}
-// 	for _, name := range include {
-// 		p := filepath.Join(wd, name)
-// 		if dirExists(p) {
-// 			// Use node IDs instead of labels with special characters
-// 			dirID := strings.ReplaceAll(name, "/", "_")
-// 			b.WriteString("  ROOT --> " + dirID + "[\"" + name + "\"]\n")
-
-// 			// Add one level of children
-// 			entries, err := os.ReadDir(p)
-// 			if err == nil {
-// 				for _, e := range entries {
-// 					label := e.Name()
-// 					// Create safe node ID by replacing special characters
-// 					childID := strings.ReplaceAll(name+"_"+label, "/", "_")
-// 					childID = strings.ReplaceAll(childID, ".", "_")
-// 					childID = strings.ReplaceAll(childID, "-", "_")
-// 					b.WriteString("  " + dirID + " --> " + childID + "[\"" + label + "\"]\n")
-// 				}
-// 			}
-// 		}
-// 	}
-// 	b.WriteString("```\n")
-// 	path := filepath.Join(outDir, "Existing_file_tree.mmd.md")
-// 	return os.WriteFile(path, []byte(b.String()), 0644)
-// }
-
-// Existing_WriteFunctionDependencyDiagram analyzes actual project functions and creates a dependency diagram
-// mode: 1 = simplified (exclude BT folders), 2 = full (all functions)
-func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error {
-	// Fix: Scan the parent directory (actual project) and focus on internal folder
-	projectRoot := filepath.Dir(wd)                       // Go up one level to the actual project
-	internalDir := filepath.Join(projectRoot, "internal") // Focus on internal directory
+// treeDirs are the top-level directories the file tree and architecture diagram consider (set from -tree-dirs)
+var treeDirs = []string{"internal", "migrations", "database", "cmd"}
 
-	// First try to scan the internal directory specifically
-	structure, err := Existing_scanProject(internalDir)
-	if err != nil {
-		// If internal directory scan fails, fall back to full project scan
-		structure, err = Existing_scanProject(projectRoot)
+// setTreeDirs parses the comma-separated -tree-dirs value
+func setTreeDirs(value string) error {
+	var dirs []string
+	for _, dir := range strings.Split(value, ",") {
+		dir = strings.Trim(strings.TrimSpace(filepath.ToSlash(dir)), "/")
+		if dir == "" {
+			continue
+		}
+		if strings.HasPrefix(dir, "..") || filepath.IsAbs(dir) {
+			return fmt.Errorf("%q must be a directory inside the project", dir)
+		}
+		dirs = append(dirs, dir)
+	}
+	if len(dirs) == 0 {
+		return fmt.Errorf("no directories given")
+	}
+	treeDirs = dirs
+	return nil
+}
+
+// fileTreeGenerator runs Existing_WriteFileTreeDiagram from the generator registry
+type fileTreeGenerator struct{}
+
+func init() { Register(fileTreeGenerator{}) }
+
+func (fileTreeGenerator) Name() string { return "file-tree" }
+
+func (fileTreeGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	return Existing_WriteFileTreeDiagram(cfg.Root, cfg.OutDir)
+}
+
+// Existing_WriteFileTreeDiagram scans the -tree-dirs directories and writes a simple Mermaid tree
+func Existing_WriteFileTreeDiagram(wd, outDir string) error {
+	var b strings.Builder
+	b.WriteString("```mermaid\n")
+	b.WriteString("graph TD\n")
+	b.WriteString("  ROOT[\"Project Root\"]\n")
+
+	used := make(map[string]bool)
+	for _, name := range treeDirs {
+		p := filepath.Join(wd, filepath.FromSlash(name))
+		if !dirExists(p) {
+			continue
+		}
+		// Use node IDs instead of labels with special characters
+		dirID := Existing_uniqueMermaidID(used, "DIR_"+name)
+		b.WriteString("  ROOT --> " + dirID + "[\"" + Existing_mermaidLabel(name) + "\"]\n")
+
+		// Add one level of children
+		entries, err := os.ReadDir(p)
 		if err != nil {
-			return fmt.Errorf("failed to scan project: %w", err)
+			continue
+		}
+		for _, e := range entries {
+			label := e.Name()
+			if e.IsDir() {
+				label += "/"
+			}
+			childID := Existing_uniqueMermaidID(used, dirID+"_"+e.Name())
+			b.WriteString("  " + dirID + " --> " + childID + "[\"" + Existing_mermaidLabel(label) + "\"]\n")
+		}
+	}
+	b.WriteString("```\n")
+	path := filepath.Join(outDir, "Existing_file_tree.mmd.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// Existing_mermaidID turns any name into a valid Mermaid node ID: letters, digits and
+// underscores only, never starting with a digit
+func Existing_mermaidID(name string) string {
+	id := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
 		}
+		return '_'
+	}, name)
+	if id == "" || id[0] >= '0' && id[0] <= '9' {
+		id = "N_" + id
+	}
+	return id
+}
+
+// Existing_uniqueMermaidID sanitizes name and adds a suffix when the ID is already taken
+// (user-api and user.api would otherwise share one node)
+func Existing_uniqueMermaidID(used map[string]bool, name string) string {
+	base := Existing_mermaidID(name)
+	id := base
+	for n := 2; used[id]; n++ {
+		id = fmt.Sprintf("%s_%d", base, n)
+	}
+	used[id] = true
+	return id
+}
+
+// Existing_mermaidLabel escapes the characters that end a quoted Mermaid label
+func Existing_mermaidLabel(label string) string {
+	return strings.ReplaceAll(label, "\"", "#quot;")
+}
+
+// Node label detail levels for the brain, connections and dependency diagrams
+const (
+	labelMinimal = "minimal" // function name only
+	labelNormal  = "normal"  // name and file
+	labelFull    = "full"    // name, file and purpose
+)
+
+// labelDetail is the node label detail level (set from -label-detail)
+var labelDetail = labelFull
+
+// setLabelDetail validates and sets the -label-detail value
+func setLabelDetail(value string) error {
+	switch value {
+	case labelMinimal, labelNormal, labelFull:
+		labelDetail = value
+		return nil
+	}
+	return fmt.Errorf("%q is not one of minimal, normal, full", value)
+}
+
+// Existing_nodeLabel picks a node label for the -label-detail level: the name, the name and
+// file on two lines, or the diagram's full label (name, file and purpose)
+func Existing_nodeLabel(name, file, full string) string {
+	switch labelDetail {
+	case labelMinimal:
+		return name
+	case labelNormal:
+		return name + "<br/>" + file
+	}
+	return full
+}
+
+// functionDependencyGenerator writes the simplified, full and handler dependency diagrams from the
+// generator registry, so -typed, -link-base and -focus apply to every run
+type functionDependencyGenerator struct{}
+
+func init() { Register(functionDependencyGenerator{}) }
+
+func (functionDependencyGenerator) Name() string { return "function-dependencies" }
+
+func (functionDependencyGenerator) Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	if structure == nil {
+		return nil
+	}
+	if err := Existing_WriteFunctionDependencyDiagram(cfg.OutDir, structure, 1); err != nil {
+		return fmt.Errorf("simplified function dependency diagram: %w", err)
 	}
+	if err := Existing_WriteFunctionDependencyDiagram(cfg.OutDir, structure, 2); err != nil {
+		return fmt.Errorf("full function dependency diagram: %w", err)
+	}
+	if err := Existing_WriteHandlerDependencyDiagram(cfg.OutDir, structure); err != nil {
+		return fmt.Errorf("handler dependency diagram: %w", err)
+	}
+	return nil
+}
 
+// Existing_WriteFunctionDependencyDiagram draws the function dependency diagram from an existing scan (no re-walk)
+// mode: 1 = simplified (exclude BT folders), 2 = full (all functions)
+func Existing_WriteFunctionDependencyDiagram(outDir string, structure *ProjectStructure, mode int) error {
 	// Filter functions based on mode - Focus on internal directory structure
 	var filteredFunctions []FunctionInfo
 	if mode == 1 {
@@ -669,6 +1467,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 		// Full mode: include all functions
 		filteredFunctions = structure.Functions
 	}
+	filteredFunctions = Existing_focusFunctions(filteredFunctions)
+
+	// init functions run at import time: drawn as their own ordered group, never as call targets
+	initFuncs := Existing_initFunctions(structure, filteredFunctions)
+	filteredFunctions = slices.DeleteFunc(slices.Clone(filteredFunctions), func(fn FunctionInfo) bool { return fn.Init })
 
 	var b strings.Builder
 	b.WriteString("```mermaid\n")
@@ -680,7 +1483,7 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 		b.WriteString("    %% FULL MODE - All functions in project\n")
 	}
 	b.WriteString("    %% Total functions found: " + fmt.Sprintf("%d", len(structure.Functions)) + "\n")
-	b.WriteString("    %% Functions included: " + fmt.Sprintf("%d", len(filteredFunctions)) + "\n\n")
+	b.WriteString("    %% Functions included: " + fmt.Sprintf("%d", len(filteredFunctions)+len(initFuncs)) + "\n\n")
 
 	// Add FIXED high-resolution styling and configuration for better HTML visibility
 	b.WriteString("    %% FIXED High-resolution configuration for HTML visibility\n")
@@ -692,8 +1495,37 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	b.WriteString("    classDef apiClass fill:#fce4ec,stroke:#c2185b,stroke-width:3px,color:#000,font-size:14px,font-weight:bold\n")
 	b.WriteString("    classDef appClass fill:#e8f5e8,stroke:#388e3c,stroke-width:3px,color:#000,font-size:14px,font-weight:bold\n")
 	b.WriteString("    classDef otherClass fill:#fafafa,stroke:#616161,stroke-width:3px,color:#000,font-size:14px,font-weight:bold\n")
+	b.WriteString("    classDef deprecatedClass fill:#fbe9e7,stroke:#bf360c,stroke-width:2px,stroke-dasharray:3 3,color:#6d4c41,font-size:14px,text-decoration:line-through\n")
+	b.WriteString("    classDef vendoredClass fill:#eeeeee,stroke:#9e9e9e,stroke-width:2px,stroke-dasharray:5 5,color:#616161,font-size:14px\n")
+	b.WriteString("    classDef initClass fill:#e0f7fa,stroke:#00838f,stroke-width:3px,color:#000,font-size:14px\n")
 	b.WriteString("\n")
 
+	// Node IDs are keyed like the typed call edges (package path, receiver, name), so
+	// (*UserStore).Get and (*MovieStore).Get are two nodes; subgraph IDs are reserved
+	used := map[string]bool{"MainApp": true, "Database": true, "Store": true, "Tokens": true,
+		"Middleware": true, "API": true, "App": true, "Other": true, "Initialization": true}
+	for i := range initFuncs {
+		used[fmt.Sprintf("init_%d", i+1)] = true
+	}
+	keyOf := Existing_funcKeys(structure.Root)
+	nodeIDs := make(map[funcKey]string)
+	nodeIDOf := func(fn FunctionInfo) string {
+		key := keyOf(fn)
+		if id, ok := nodeIDs[key]; ok {
+			return id
+		}
+		name := fn.Name
+		if fn.Receiver != "" {
+			name = fn.Receiver + "_" + fn.Name
+		}
+		id := Existing_uniqueMermaidID(used, name)
+		nodeIDs[key] = id
+		return id
+	}
+	for _, fn := range filteredFunctions {
+		nodeIDOf(fn)
+	}
+
 	// Group functions by internal directory structure
 	appFuncs := []FunctionInfo{}
 	storeFuncs := []FunctionInfo{}
@@ -738,18 +1570,32 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 		}
 	}
 
+	// init functions first: they run at import time, before main
+	if len(initFuncs) > 0 {
+		b.WriteString("    subgraph Initialization[\"⚙️ INITIALIZATION (import-time, in run order)\"]\n")
+		for i, fn := range initFuncs {
+			dir := path.Dir(fn.File)
+			if dir == "." {
+				dir = "module root"
+			}
+			name, file := fmt.Sprintf("%d. %s.init()", i+1, fn.Package), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        init_%d[\"%s\"]:::initClass\n", i+1, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>📦 "+dir+Existing_todoBadge(fn))))
+			if i > 0 {
+				b.WriteString(fmt.Sprintf("        init_%d -.-> init_%d\n", i, i+1))
+			}
+		}
+		b.WriteString("    end\n\n")
+	}
+
 	// Write main functions first
 	if len(mainFuncs) > 0 {
 		b.WriteString("    subgraph MainApp[\"🚀 MAIN APPLICATION (Entry Point)\"]\n")
 		for _, fn := range mainFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -758,14 +1604,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	if len(databaseFuncs) > 0 {
 		b.WriteString("    subgraph Database[\"🗄️ DATABASE LAYER (internal/database)\"]\n")
 		for _, fn := range databaseFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -774,14 +1617,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	if len(storeFuncs) > 0 {
 		b.WriteString("    subgraph Store[\"💾 STORE LAYER (internal/store)\"]\n")
 		for _, fn := range storeFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -790,14 +1630,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	if len(tokenFuncs) > 0 {
 		b.WriteString("    subgraph Tokens[\"🔑 TOKEN LAYER (internal/tokens)\"]\n")
 		for _, fn := range tokenFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -806,14 +1643,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	if len(middlewareFuncs) > 0 {
 		b.WriteString("    subgraph Middleware[\"🛡️ MIDDLEWARE LAYER (internal/middleware)\"]\n")
 		for _, fn := range middlewareFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -822,14 +1656,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	if len(apiFuncs) > 0 {
 		b.WriteString("    subgraph API[\"🌐 API LAYER (internal/api)\"]\n")
 		for _, fn := range apiFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -838,14 +1669,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	if len(appFuncs) > 0 {
 		b.WriteString("    subgraph App[\"🏗️ APPLICATION LAYER (internal/app)\"]\n")
 		for _, fn := range appFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -854,14 +1682,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	if len(otherFuncs) > 0 {
 		b.WriteString("    subgraph Other[\"📦 OTHER FUNCTIONS\"]\n")
 		for _, fn := range otherFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -870,14 +1695,11 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	if len(mainFuncs) > 0 {
 		b.WriteString("    subgraph MainApp[\"🚀 MAIN FUNCTIONS (Build Last)\"]\n")
 		for _, fn := range mainFuncs {
-			nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-			nodeID = strings.ReplaceAll(nodeID, "-", "_")
-			shortPurpose := fn.Purpose
-			if len(shortPurpose) > 35 {
-				shortPurpose = shortPurpose[:32] + "..."
-			}
-			b.WriteString(fmt.Sprintf("        %s[\"%s()<br/>📁 %s<br/>%s\"]\n",
-				nodeID, fn.Name, filepath.Base(fn.File), shortPurpose))
+			nodeID := nodeIDOf(fn)
+			shortPurpose := Existing_truncate(fn.Purpose, 35)
+			name, file := Existing_diagramName(fn), "📁 "+filepath.Base(fn.File)
+			b.WriteString(fmt.Sprintf("        %s[\"%s\"]\n",
+				nodeID, Existing_nodeLabel(name, file, name+"<br/>"+file+"<br/>"+shortPurpose+Existing_todoBadge(fn))))
 		}
 		b.WriteString("    end\n\n")
 	}
@@ -885,25 +1707,115 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 	// Add comprehensive dependency relationships based on actual project analysis
 	b.WriteString("    %% ENHANCED dependency patterns (based on actual project analysis)\n")
 
-	// Create a map of function names to node IDs for easier lookup
-	funcMap := make(map[string]string)
+	// With -typed, edges come from type-checked calls; otherwise from name heuristics
+	typedEdges := false
+	if typedMode {
+		edges, err := Existing_typedCallEdges(structure.Root)
+		if err != nil {
+			fmt.Printf("⚠️  -typed: %v (falling back to name heuristics)\n", err)
+		} else {
+			b.WriteString("    %% TYPED MODE - edges resolved with go/types\n")
+			for _, edge := range edges {
+				from, fromOK := nodeIDs[edge.From]
+				to, toOK := nodeIDs[edge.To]
+				if fromOK && toOK && from != to {
+					b.WriteString(fmt.Sprintf("    %s --> %s\n", from, to))
+				}
+			}
+			typedEdges = true
+		}
+	}
+	if !typedEdges {
+		Existing_writeHeuristicEdges(&b, filteredFunctions, nodeIDOf)
+	}
+
+	// Apply CSS classes to function nodes for better styling
+	b.WriteString("    %% Apply styling classes\n")
 	for _, fn := range filteredFunctions {
-		nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-		nodeID = strings.ReplaceAll(nodeID, "-", "_")
-		funcMap[strings.ToLower(fn.Name)] = nodeID
+		nodeID := nodeIDOf(fn)
+		filePath := strings.ToLower(fn.File)
+		funcName := strings.ToLower(fn.Name)
+
+		// Determine class based on internal directory structure
+		var className string
+		if fn.Deprecated {
+			className = "deprecatedClass"
+		} else if fn.Vendored {
+			className = "vendoredClass"
+		} else if strings.Contains(filePath, "main") || funcName == "main" {
+			className = "mainClass"
+		} else if strings.Contains(filePath, "internal/database") || strings.Contains(filePath, "database") {
+			className = "databaseClass"
+		} else if strings.Contains(filePath, "internal/store") || strings.Contains(filePath, "store") {
+			className = "storeClass"
+		} else if strings.Contains(filePath, "internal/tokens") || strings.Contains(filePath, "tokens") {
+			className = "tokenClass"
+		} else if strings.Contains(filePath, "internal/middleware") || strings.Contains(filePath, "middleware") {
+			className = "middlewareClass"
+		} else if strings.Contains(filePath, "internal/api") || strings.Contains(filePath, "api") {
+			className = "apiClass"
+		} else if strings.Contains(filePath, "internal/app") || strings.Contains(filePath, "app") {
+			className = "appClass"
+		} else {
+			className = "otherClass"
+		}
+
+		b.WriteString(fmt.Sprintf("    class %s %s\n", nodeID, className))
+	}
+
+	// With -link-base, clicking a node opens its source line
+	if linkBase != "" {
+		Existing_writeClickLinks(&b, filteredFunctions, structure.Root, nodeIDOf)
+	}
+
+	b.WriteString("```\n")
+
+	// Write to file
+	var filename string
+	if mode == 1 {
+		filename = "Existing_function_dependencies_simplified.mmd.md"
+	} else {
+		filename = "Existing_function_dependencies_full.mmd.md"
+	}
+	path := filepath.Join(outDir, filename)
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
+// linkBase is the URL source links are built from, e.g. a GitHub blob URL (set from -link-base; "" = no links)
+var linkBase string
+
+// Existing_writeClickLinks adds a Mermaid click directive per function node pointing at <linkBase>/<relpath>#L<line>
+func Existing_writeClickLinks(b *strings.Builder, functions []FunctionInfo, root string, nodeIDOf func(FunctionInfo) string) {
+	// Links are relative to the module root, which is what a repository URL points at
+	modRoot, _ := findModuleRoot(root)
+	b.WriteString("    %% Click-to-source links\n")
+	seen := make(map[string]bool)
+	for _, fn := range functions {
+		nodeID := nodeIDOf(fn)
+		if seen[nodeID] {
+			continue
+		}
+		seen[nodeID] = true
+		rel := Existing_relSlash(modRoot, filepath.Join(root, filepath.FromSlash(fn.File)))
+		link := strings.TrimSuffix(linkBase, "/") + "/" + (&url.URL{Path: rel}).EscapedPath() + fmt.Sprintf("#L%d", fn.Line)
+		b.WriteString(fmt.Sprintf("    click %s \"%s\" \"Open %s\" _blank\n", nodeID, link, rel))
 	}
+}
 
-	// Enhanced dependency analysis based on actual project structure
+// Existing_writeHeuristicEdges guesses dependency edges from function and file names
+func Existing_writeHeuristicEdges(b *strings.Builder, filteredFunctions []FunctionInfo, nodeIDOf func(FunctionInfo) string) {
 	for _, fn := range filteredFunctions {
 		funcName := strings.ToLower(fn.Name)
 		fileName := strings.ToLower(filepath.Base(fn.File))
-		nodeID := funcMap[funcName]
+		nodeID := nodeIDOf(fn)
 
 		// 1. Main function dependencies
 		if funcName == "main" {
 			// Main typically calls NewApplication
-			if newNodeID, exists := funcMap["newapplication"]; exists {
-				b.WriteString(fmt.Sprintf("    %s --> %s\n", nodeID, newNodeID))
+			for _, otherFn := range filteredFunctions {
+				if strings.ToLower(otherFn.Name) == "newapplication" {
+					b.WriteString(fmt.Sprintf("    %s --> %s\n", nodeID, nodeIDOf(otherFn)))
+				}
 			}
 		}
 
@@ -914,7 +1826,7 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 				otherName := strings.ToLower(otherFn.Name)
 				if (strings.Contains(otherName, "new") && strings.Contains(otherName, "store")) ||
 					(strings.Contains(otherName, "new") && strings.Contains(otherName, "handler")) {
-					otherNodeID := funcMap[otherName]
+					otherNodeID := nodeIDOf(otherFn)
 					b.WriteString(fmt.Sprintf("    %s --> %s\n", nodeID, otherNodeID))
 				}
 			}
@@ -931,7 +1843,7 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 				otherName := strings.ToLower(otherFn.Name)
 				if strings.Contains(otherName, "new") && strings.Contains(otherName, "store") &&
 					strings.Contains(otherName, resourceType) {
-					otherNodeID := funcMap[otherName]
+					otherNodeID := nodeIDOf(otherFn)
 					b.WriteString(fmt.Sprintf("    %s --> %s\n", otherNodeID, nodeID))
 				}
 			}
@@ -944,7 +1856,7 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 				otherName := strings.ToLower(otherFn.Name)
 				if strings.Contains(otherName, "open") || strings.Contains(otherName, "connect") ||
 					strings.Contains(otherName, "database") {
-					otherNodeID := funcMap[otherName]
+					otherNodeID := nodeIDOf(otherFn)
 					b.WriteString(fmt.Sprintf("    %s --> %s\n", otherNodeID, nodeID))
 				}
 			}
@@ -956,7 +1868,7 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 			for _, otherFn := range filteredFunctions {
 				otherName := strings.ToLower(otherFn.Name)
 				if strings.Contains(otherName, "migrate") {
-					otherNodeID := funcMap[otherName]
+					otherNodeID := nodeIDOf(otherFn)
 					b.WriteString(fmt.Sprintf("    %s --> %s\n", otherNodeID, nodeID))
 				}
 			}
@@ -968,7 +1880,7 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 			for _, otherFn := range filteredFunctions {
 				otherName := strings.ToLower(otherFn.Name)
 				if strings.Contains(otherName, "handler") && strings.Contains(otherName, "new") {
-					otherNodeID := funcMap[otherName]
+					otherNodeID := nodeIDOf(otherFn)
 					b.WriteString(fmt.Sprintf("    %s --> %s\n", otherNodeID, nodeID))
 				}
 			}
@@ -980,55 +1892,12 @@ func Existing_WriteFunctionDependencyDiagram(wd, outDir string, mode int) error
 			for _, otherFn := range filteredFunctions {
 				otherName := strings.ToLower(otherFn.Name)
 				if strings.Contains(otherName, "token") || strings.Contains(otherName, "user") {
-					otherNodeID := funcMap[otherName]
+					otherNodeID := nodeIDOf(otherFn)
 					b.WriteString(fmt.Sprintf("    %s --> %s\n", otherNodeID, nodeID))
 				}
 			}
 		}
 	}
-
-	// Apply CSS classes to function nodes for better styling
-	b.WriteString("    %% Apply styling classes\n")
-	for _, fn := range filteredFunctions {
-		nodeID := strings.ReplaceAll(fn.Name, ".", "_")
-		nodeID = strings.ReplaceAll(nodeID, "-", "_")
-		filePath := strings.ToLower(fn.File)
-		funcName := strings.ToLower(fn.Name)
-
-		// Determine class based on internal directory structure
-		var className string
-		if strings.Contains(filePath, "main") || funcName == "main" {
-			className = "mainClass"
-		} else if strings.Contains(filePath, "internal/database") || strings.Contains(filePath, "database") {
-			className = "databaseClass"
-		} else if strings.Contains(filePath, "internal/store") || strings.Contains(filePath, "store") {
-			className = "storeClass"
-		} else if strings.Contains(filePath, "internal/tokens") || strings.Contains(filePath, "tokens") {
-			className = "tokenClass"
-		} else if strings.Contains(filePath, "internal/middleware") || strings.Contains(filePath, "middleware") {
-			className = "middlewareClass"
-		} else if strings.Contains(filePath, "internal/api") || strings.Contains(filePath, "api") {
-			className = "apiClass"
-		} else if strings.Contains(filePath, "internal/app") || strings.Contains(filePath, "app") {
-			className = "appClass"
-		} else {
-			className = "otherClass"
-		}
-
-		b.WriteString(fmt.Sprintf("    class %s %s\n", nodeID, className))
-	}
-
-	b.WriteString("```\n")
-
-	// Write to file
-	var filename string
-	if mode == 1 {
-		filename = "Existing_function_dependencies_simplified.mmd.md"
-	} else {
-		filename = "Existing_function_dependencies_full.mmd.md"
-	}
-	path := filepath.Join(outDir, filename)
-	return os.WriteFile(path, []byte(b.String()), 0644)
 }
 
 // Helper functions for file/directory existence checks are defined in BTProjectDiagrams.go
@@ -0,0 +1,141 @@
+//go:build flowcharts
+
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestPurposeForIsRuneSafe(t *testing.T) {
+	purpose, source := Existing_purposeFor(FunctionInfo{Name: "Greet", Doc: "Greet éléve " + strings.Repeat("ü", 100)})
+	if source != purposeDoc || !strings.HasPrefix(purpose, "Éléve ") {
+		t.Errorf("purpose = %q (%s), want it capitalised from the doc", purpose, source)
+	}
+	if !utf8.ValidString(purpose) || utf8.RuneCountInString(purpose) != 80 || !strings.HasSuffix(purpose, "...") {
+		t.Errorf("purpose = %q, want 80 valid runes ending in ...", purpose)
+	}
+}
+
+func TestTruncateKeepsShortStrings(t *testing.T) {
+	if got := Existing_truncate("日本語", 3); got != "日本語" {
+		t.Errorf("Existing_truncate = %q", got)
+	}
+	if got := Existing_truncate("日本語のテキスト", 5); got != "日本..." {
+		t.Errorf("Existing_truncate = %q", got)
+	}
+}
+
+func TestSkipScanDirMatchesIncludeVendor(t *testing.T) {
+	defer func(saved bool) { includeVendor = saved }(includeVendor)
+	root := "/p"
+	for _, tt := range []struct {
+		path, name    string
+		vendor, skips bool
+	}{
+		{"/p", "p", false, false},
+		{"/p/.git", ".git", false, true},
+		{"/p/internal/testdata", "testdata", true, true},
+		{"/p/vendor", "vendor", false, true},
+		{"/p/vendor", "vendor", true, false},
+		{"/p/internal", "internal", false, false},
+	} {
+		includeVendor = tt.vendor
+		if got := Existing_skipScanDir(root, tt.path, tt.name); got != tt.skips {
+			t.Errorf("Existing_skipScanDir(%s, includeVendor=%v) = %v, want %v", tt.path, tt.vendor, got, tt.skips)
+		}
+	}
+}
+
+func TestRelSlash(t *testing.T) {
+	root := filepath.Join("proj", "svc")
+	tests := []struct{ path, want string }{
+		{filepath.Join(root, "internal", "store", "user.go"), "internal/store/user.go"},
+		{root, "."},
+		{filepath.Join("elsewhere", "x.go"), "elsewhere/x.go"},
+	}
+	for _, tt := range tests {
+		if got := Existing_relSlash(root, tt.path); got != tt.want {
+			t.Errorf("Existing_relSlash(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestUniqueMermaidIDSanitizesAwkwardNames(t *testing.T) {
+	valid := regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	names := []string{"user-api", "user.api", "v1.2", "00001_create-users.sql", "my dir", "ünïcode", ""}
+	used := make(map[string]bool)
+	for _, name := range names {
+		if id := Existing_uniqueMermaidID(used, name); !valid.MatchString(id) {
+			t.Errorf("Mermaid ID for %q is %q", name, id)
+		}
+	}
+	if len(used) != len(names) {
+		t.Errorf("%d names gave %d distinct IDs", len(names), len(used))
+	}
+}
+
+// The dependency diagram draws the scanned module itself, not a directory next to it
+func TestFunctionDependencyDiagramNormalLayout(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "sample")
+	if err := SelfTest_writeSample(root); err != nil {
+		t.Fatal(err)
+	}
+	structure, err := Existing_scanProject(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outDir := t.TempDir()
+	if err := Existing_WriteFunctionDependencyDiagram(outDir, structure, 2); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(outDir, "Existing_function_dependencies_full.mmd.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	diagram := string(data)
+	if want := fmt.Sprintf("%%%% Functions included: %d\n", len(structure.Functions)); !strings.Contains(diagram, want) || !strings.Contains(diagram, "GetUserByID") {
+		t.Errorf("diagram does not include the sample's %d functions", len(structure.Functions))
+	}
+	if strings.Contains(diagram, "\\") || strings.Contains(diagram, root) {
+		t.Error("diagram contains backslashes or absolute paths")
+	}
+}
+
+// Only components of more than one package are cycles; a package importing into a cycle is not part of it
+func TestImportCyclesStronglyConnected(t *testing.T) {
+	tests := []struct {
+		name    string
+		imports map[string]map[string]bool
+		want    string
+	}{
+		{"no cycle", map[string]map[string]bool{"a": {"b": true}, "b": {"c": true}}, "[]"},
+		{"three packages", map[string]map[string]bool{"a": {"b": true}, "b": {"c": true}, "c": {"a": true}, "d": {"a": true}}, "[[a b c]]"},
+		{"two cycles", map[string]map[string]bool{"a": {"b": true}, "b": {"a": true}, "c": {"d": true}, "d": {"c": true}}, "[[a b] [c d]]"},
+	}
+	for _, tt := range tests {
+		var cycles [][]string
+		for _, component := range Existing_stronglyConnected([]string{"a", "b", "c", "d"}, tt.imports) {
+			if len(component) > 1 {
+				cycles = append(cycles, component)
+			}
+		}
+		if got := fmt.Sprint(cycles); got != tt.want {
+			t.Errorf("%s: cycles = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+GENERATOR REGISTRY - PLUG-IN DIAGRAM GENERATORS
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file lets new diagram generators plug into the pipeline
+             without touching the orchestrator. A generator implements the
+             Generator interface and registers itself from init(); every run
+             then calls all registered generators with the scanned project
+             structure.
+
+TO ADD A GENERATOR:
+1. Create a new file with a type implementing Name() and Generate()
+2. Call Register(yourGenerator{}) from that file's init()
+3. Write your output into cfg.OutDir - nothing else needs to change
+
+NOTES:
+- structure can be nil when the project scan failed; generators that need
+  it should return early
+- Generators run in name order so output is reproducible
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// GeneratorConfig is what every registered generator receives besides the structure
+type GeneratorConfig struct {
+	Root    string // project root (module root when found)
+	OutDir  string // directory to write diagrams into
+	Options FlowchartOptions
+}
+
+// Generator is a diagram or report generator that can be registered with the pipeline
+type Generator interface {
+	Name() string
+	Generate(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error
+}
+
+// generatorRegistry holds every generator registered from init()
+var generatorRegistry = map[string]Generator{}
+
+// Register adds a generator to the pipeline. It panics on duplicate names so
+// clashing plug-ins are caught at startup rather than silently overwritten.
+func Register(g Generator) {
+	name := g.Name()
+	if _, exists := generatorRegistry[name]; exists {
+		panic(fmt.Sprintf("generator %q registered twice", name))
+	}
+	generatorRegistry[name] = g
+}
+
+// registeredGenerators returns the registered generators sorted by name
+func registeredGenerators() []Generator {
+	names := make([]string, 0, len(generatorRegistry))
+	for name := range generatorRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	list := make([]Generator, 0, len(names))
+	for _, name := range names {
+		list = append(list, generatorRegistry[name])
+	}
+	return list
+}
+
+// runGenerators calls every registered generator and returns the joined errors.
+// One failing generator does not stop the others.
+func runGenerators(ctx context.Context, cfg GeneratorConfig, structure *ProjectStructure) error {
+	var errs []error
+	for _, g := range registeredGenerators() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		stop := profileStep(g.Name())
+		err := g.Generate(ctx, cfg, structure)
+		stop()
+		if err != nil {
+			fmt.Printf("⚠️  Generator %s failed: %v\n", g.Name(), err)
+			errs = append(errs, fmt.Errorf("%s: %w", g.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,96 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+GIT WORKTREE - DIAGRAMS FOR A PAST TAG OR BRANCH (-ref)
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: To document a past release without touching the current checkout,
+             -ref checks the tag, branch or commit out into a temporary
+             `git worktree`, runs the normal pipeline against it and removes
+             the worktree again - also when generation fails. The diagrams go
+             to <out>/ref-<ref>/ (dots and slashes become '_') together with
+             GIT_REF.md naming the commit.
+
+TO USE THIS FILE:
+1. Run with -ref v1.0.0 (the project must be inside a git repository)
+2. Open <out>/ref-v1_0_0/
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// withGitWorktree checks ref out into a temporary worktree of the repository containing
+// repoDir, calls fn with its path and always removes the worktree afterwards
+func withGitWorktree(repoDir, ref string, fn func(dir string) error) error {
+	tmp, err := os.MkdirTemp("", "bt-worktree-")
+	if err != nil {
+		return fmt.Errorf("create worktree dir: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	dir := filepath.Join(tmp, "src")
+	if out, err := gitCommand(repoDir, "worktree", "add", "--detach", dir, ref).CombinedOutput(); err != nil {
+		return fmt.Errorf("git worktree add %s: %w: %s", ref, err, strings.TrimSpace(string(out)))
+	}
+	defer func() {
+		if out, err := gitCommand(repoDir, "worktree", "remove", "--force", dir).CombinedOutput(); err != nil {
+			fmt.Printf("⚠️  could not remove worktree %s: %v: %s\n", dir, err, strings.TrimSpace(string(out)))
+		}
+	}()
+
+	fmt.Printf("🌿 Checked out %s into temporary worktree %s\n", ref, dir)
+	return fn(dir)
+}
+
+// runAtGitRef generates the diagrams of projectRoot as of ref into outAbs/ref-<ref>
+func runAtGitRef(projectRoot, outAbs, ref string, opts FlowchartOptions) error {
+	// The project may live in a subdirectory of the repository
+	prefix, err := gitCommand(projectRoot, "rev-parse", "--show-prefix").Output()
+	if err != nil {
+		return fmt.Errorf("%s is not inside a git repository: %w", projectRoot, err)
+	}
+	commit, err := gitCommand(projectRoot, "rev-parse", "--verify", "--short", ref+"^{commit}").Output()
+	if err != nil {
+		return fmt.Errorf("unknown git ref %q: %w", ref, err)
+	}
+
+	refOut := filepath.Join(outAbs, "ref-"+Existing_safeFileName(ref))
+	return withGitWorktree(projectRoot, ref, func(dir string) error {
+		if err := ensureDir(refOut); err != nil {
+			return err
+		}
+		note := fmt.Sprintf("# Diagrams for `%s`\n\n- **Commit:** `%s`\n- **Generated:** %s\n",
+			ref, strings.TrimSpace(string(commit)), time.Now().Format("2006-01-02 15:04:05"))
+		if err := os.WriteFile(filepath.Join(refOut, "GIT_REF.md"), []byte(note), 0644); err != nil {
+			return err
+		}
+		return BTFlowcharts(filepath.Join(dir, filepath.FromSlash(strings.TrimSpace(string(prefix)))), refOut, opts)
+	})
+}
+
+// gitCommand returns a git command that runs in dir, leaving the process working directory alone
+func gitCommand(dir string, args ...string) *exec.Cmd {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	return cmd
+}
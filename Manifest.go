@@ -0,0 +1,125 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+MANIFEST - EVERY OUTPUT FILE WITH ITS SIZE AND SHA-256
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: At the end of a run the output directory is walked and
+             manifest.json lists every file in it: path (relative, forward
+             slashes), size and SHA-256. Entries are sorted by path and the
+             manifest holds no timestamps, so two runs over the same code give
+             byte-identical manifests and CI can cache on the file or diff two
+             of them to see which outputs changed. Files left over from
+             earlier runs are listed too - clean the directory first for a
+             manifest of this run only.
+
+TO USE THIS FILE:
+1. Written by default at the end of main, after the score gate and the CPU
+   profile; -no-manifest turns it off (-task runs and -serve write none)
+2. diff old/manifest.json new/manifest.json
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// manifestName is the manifest's file name in the output directory
+const manifestName = "manifest.json"
+
+// noManifest skips manifest.json at the end of a run (set from -no-manifest)
+var noManifest bool
+
+// ManifestEntry is one output file
+type ManifestEntry struct {
+	Path   string `json:"path"` // relative to the output directory, forward slashes
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest lists the files of an output directory, sorted by path
+type Manifest struct {
+	Files []ManifestEntry `json:"files"`
+}
+
+// writeManifest writes <outDir>/manifest.json for every file under outDir and returns how many it lists
+func writeManifest(outDir string) (int, error) {
+	var manifest Manifest
+	err := filepath.WalkDir(outDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel := Existing_relSlash(outDir, path)
+		if rel == manifestName {
+			return nil
+		}
+		entry, err := manifestEntry(path)
+		if err != nil {
+			return fmt.Errorf("%s: %w", rel, err)
+		}
+		entry.Path = rel
+		manifest.Files = append(manifest.Files, entry)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	sort.Slice(manifest.Files, func(i, j int) bool { return manifest.Files[i].Path < manifest.Files[j].Path })
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return 0, fmt.Errorf("marshal manifest: %w", err)
+	}
+	return len(manifest.Files), os.WriteFile(filepath.Join(outDir, manifestName), append(data, '\n'), 0644)
+}
+
+// writeRunManifest writes the manifest at the end of a run and reports it; a failure is
+// only returned with -strict
+func writeRunManifest(outDir string) error {
+	n, err := writeManifest(outDir)
+	if err != nil {
+		fmt.Printf("⚠️  Manifest failed: %v (continuing)\n", err)
+		return strictErr("manifest", err)
+	}
+	fmt.Printf("🧾 Wrote %s (%d files)\n", filepath.Join(outDir, manifestName), n)
+	return nil
+}
+
+// manifestEntry hashes one file
+func manifestEntry(path string) (ManifestEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return ManifestEntry{}, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return ManifestEntry{}, err
+	}
+	return ManifestEntry{Size: size, SHA256: hex.EncodeToString(h.Sum(nil))}, nil
+}
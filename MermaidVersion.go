@@ -0,0 +1,105 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+MERMAID VERSION - PINNED MERMAID.JS AND FEATURE GATING
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The generated HTML pages load Mermaid.js from a CDN. This file
+             pins that version (-mermaid-version) so charts render the same
+             way every time, and lets generators ask whether a newer diagram
+             type (mindmap, timeline, ...) is available in the pinned version
+             before writing it.
+
+TO USE THIS FILE:
+1. Call setMermaidVersion() once at startup (main does this from -mermaid-version)
+2. Use mermaidScriptURL() in every generated HTML page
+3. Check mermaidSupports("feature") before emitting a newer diagram type
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// defaultMermaidVersion is the Mermaid.js release the generated HTML is tested with
+const defaultMermaidVersion = "10.9.1"
+
+// mermaidVersion is the pinned Mermaid.js version for this run ("latest" = unpinned)
+var mermaidVersion = defaultMermaidVersion
+
+// mermaidFeatureMinVersion lists the first Mermaid.js release supporting each diagram type
+var mermaidFeatureMinVersion = map[string]string{
+	"mindmap":      "9.4.0",
+	"gitGraph":     "9.2.0",
+	"xychart-beta": "10.3.0",
+}
+
+// setMermaidVersion validates and selects the Mermaid.js version
+func setMermaidVersion(version string) error {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "latest" {
+		mermaidVersion = version
+		return nil
+	}
+	if _, ok := parseMermaidVersion(version); !ok {
+		return fmt.Errorf("%q is not a version like 10.9.1 or \"latest\"", version)
+	}
+	mermaidVersion = version
+	return nil
+}
+
+// mermaidScriptURL returns the CDN URL of the pinned Mermaid.js build
+func mermaidScriptURL() string {
+	if mermaidVersion == "latest" {
+		return "https://cdn.jsdelivr.net/npm/mermaid/dist/mermaid.min.js"
+	}
+	return "https://cdn.jsdelivr.net/npm/mermaid@" + mermaidVersion + "/dist/mermaid.min.js"
+}
+
+// mermaidSupports reports whether the pinned Mermaid.js version can render a diagram type
+func mermaidSupports(feature string) bool {
+	minVersion, ok := mermaidFeatureMinVersion[feature]
+	if !ok || mermaidVersion == "latest" {
+		return true
+	}
+	have, _ := parseMermaidVersion(mermaidVersion)
+	need, _ := parseMermaidVersion(minVersion)
+	for i := range have {
+		if have[i] != need[i] {
+			return have[i] > need[i]
+		}
+	}
+	return true
+}
+
+// parseMermaidVersion parses "major.minor.patch" (minor and patch optional)
+func parseMermaidVersion(version string) ([3]int, bool) {
+	var parts [3]int
+	fields := strings.Split(version, ".")
+	if len(fields) == 0 || len(fields) > 3 {
+		return parts, false
+	}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return parts, false
+		}
+		parts[i] = n
+	}
+	return parts, true
+}
@@ -0,0 +1,310 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+MESSAGES - REPORT TEXT CATALOG (i18n)
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file holds the user-facing text of the generated reports
+             (phase names, report headers, advice, ratings) in a message
+             catalog keyed by language. The language is chosen once per run
+             with the -lang flag; English is the fallback for any key that a
+             language does not translate yet.
+
+TO USE THIS FILE:
+1. Call setLanguage() once at startup (main does this from -lang)
+2. Use tr("key") wherever report text is written
+3. Use trPhase(name) for phase names - the English name stays the internal key
+
+ADDING A LANGUAGE:
+- Add a new entry to messageCatalog with the keys you can translate
+- Missing keys automatically fall back to English
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// currentLanguage is the catalog used by tr() for this run
+var currentLanguage = "en"
+
+// messageCatalog maps language -> key -> text
+var messageCatalog = map[string]map[string]string{
+	"en": {
+		// Development phases (Existing_determinePhase)
+		"phase.Foundation":        "Foundation",
+		"phase.Data Layer":        "Data Layer",
+		"phase.Store Layer":       "Store Layer",
+		"phase.Application Layer": "Application Layer",
+		"phase.API Layer":         "API Layer",
+		"phase.Routing Layer":     "Routing Layer",
+		"phase.Main App":          "Main App",
+
+		// Evaluator phases (ProjectEvaluator_DetermineProgress)
+		"phase.Project Initialization":      "Project Initialization",
+		"phase.Application Foundation":      "Application Foundation",
+		"phase.Internal Structure":          "Internal Structure",
+		"phase.Database Layer":              "Database Layer",
+		"phase.Authentication & Middleware": "Authentication & Middleware",
+		"phase.Testing & Deployment":        "Testing & Deployment",
+
+		// Next steps
+		"next.Project Initialization":      "Create main.go and initialize Go module",
+		"next.Application Foundation":      "Create internal directory structure",
+		"next.Internal Structure":          "Implement application layer with app.go",
+		"next.Application Layer":           "Create API handlers in internal/api",
+		"next.API Layer":                   "Set up database connection and migrations",
+		"next.Database Layer":              "Implement store layer for data access",
+		"next.Store Layer":                 "Add authentication and middleware",
+		"next.Authentication & Middleware": "Write comprehensive tests",
+		"next.Testing & Deployment":        "Optimize and deploy the application",
+		"next.default":                     "Review and refactor existing code",
+
+		// Advice
+		"advice.structure":        "🔧 Improve project structure by creating missing directories",
+		"advice.quality":          "📝 Add proper error handling and logging",
+		"advice.core":             "🚀 Focus on completing the core application layers first",
+		"advice.auth":             "🔐 Implement authentication and middleware",
+		"advice.testing":          "🧪 Add comprehensive testing and documentation",
+		"advice.errors":           "❌ Fix critical errors before proceeding",
+		"advice.warnings":         "⚠️ Address warnings to improve code quality",
+		"rating.excellent":        "🌟 EXCELLENT",
+		"rating.verygood":         "⭐ VERY GOOD",
+		"rating.good":             "👍 GOOD",
+		"rating.fair":             "📈 FAIR",
+		"rating.improvement":      "⚠️ NEEDS IMPROVEMENT",
+		"rating.attention":        "🚨 REQUIRES ATTENTION",
+		"subscore.Structure":      "Structure",
+		"subscore.Progress":       "Progress",
+		"subscore.Testing":        "Testing",
+		"subscore.Code Quality":   "Code Quality",
+		"subscore.Error Handling": "Error Handling",
+		"subscore.Documentation":  "Documentation",
+		"subscore.Configuration":  "Configuration",
+
+		// Assessment report headers and labels
+		"report.assessment.title":     "🔍 COMPREHENSIVE PROJECT EVALUATION REPORT",
+		"report.assessment.overview":  "📊 PROJECT STATUS OVERVIEW",
+		"report.assessment.progress":  "📈 PROGRESS ANALYSIS",
+		"report.assessment.quality":   "🏆 QUALITY ASSESSMENT",
+		"report.assessment.subscores": "📊 DETAILED SUB-SCORES",
+		"report.assessment.advice":    "💡 INTELLIGENT ADVICE & RECOMMENDATIONS",
+		"report.assessment.final":     "🎯 FINAL ASSESSMENT",
+		"label.evaluationDate":        "📅 Evaluation Date",
+		"label.currentPhase":          "🎯 Current Phase",
+		"label.completion":            "📈 Completion",
+		"label.completionPercent":     "📊 Completion Percentage",
+		"label.finalScore":            "🏆 Final Score",
+		"label.overallScore":          "🏆 Overall Score",
+		"label.rating":                "⭐ Rating",
+		"label.nextStep":              "⏭️ Next Step",
+		"label.status":                "🔍 Status",
+		"label.structureScore":        "🏗️ Structure Score",
+		"label.codeQuality":           "📝 Code Quality",
+		"label.errors":                "❌ Errors",
+		"label.warnings":              "⚠️ Warnings",
+		"label.progress":              "📈 Progress",
+		"label.complete":              "Complete",
+		"label.focus":                 "🎯 Focus",
+		"label.layout":                "🗂️ Layout",
+		"layout.internal":             "internal/ (app, api, store)",
+		"layout.cmd":                  "cmd/ + libraries",
+		"layout.pkg":                  "pkg/ libraries",
+		"layout.flat":                 "flat single package",
+		"layout.custom":               "custom",
+
+		// Existing_* reports
+		"report.inventory.title":      "# Existing Function Inventory - Auto-Generated",
+		"report.inventory.intro":      "This document provides a comprehensive inventory of all functions currently existing in the project.",
+		"report.inventory.package":    "Package",
+		"report.inventory.files":      "Files",
+		"report.inventory.functions":  "Functions",
+		"report.inventory.methodOn":   "method on",
+		"report.inventory.file":       "File",
+		"report.inventory.line":       "line",
+		"report.inventory.inferred":   "(inferred)",
+		"report.inventory.deprecated": "🚫 deprecated",
+		"report.deprecated":           "🚫 Deprecated Functions",
+		"report.deprecated.intro":     "Functions whose doc comment has a `Deprecated:` paragraph - candidates for removal.",
+		"report.summary":              "Summary",
+		"report.totalFunctions":       "Total Functions",
+		"report.totalFiles":           "Total Files",
+		"report.totalPackages":        "Total Packages",
+		"report.totalTODOs":           "Outstanding TODO/FIXME",
+		"report.todoFiles":            "📝 TODO/FIXME by File",
+		"report.sequence.title":       "# Existing Dynamic Development Sequence - Auto-Generated",
+		"report.sequence.intro":       "This diagram shows the **order in which functions should be created** based on the current project structure.\nUnderstanding this helps you know **where to start** when building similar projects.",
+		"report.sequence.phase":       "PHASE",
+		"report.status.title":         "# Existing Project Status Report - Auto-Generated",
+		"report.status.generated":     "Generated",
+		"report.status.statistics":    "## 📊 Current Project Statistics",
+		"report.status.packages":      "## 📁 Current Package Breakdown",
+		"report.status.phases":        "## 🎯 Current Development Phases",
+		"unit.files":                  "files",
+		"unit.functions":              "functions",
+	},
+	"fr": {
+		"phase.Foundation":        "Fondations",
+		"phase.Data Layer":        "Couche de données",
+		"phase.Store Layer":       "Couche de stockage",
+		"phase.Application Layer": "Couche application",
+		"phase.API Layer":         "Couche API",
+		"phase.Routing Layer":     "Couche de routage",
+		"phase.Main App":          "Application principale",
+
+		"phase.Project Initialization":      "Initialisation du projet",
+		"phase.Application Foundation":      "Fondations de l'application",
+		"phase.Internal Structure":          "Structure interne",
+		"phase.Database Layer":              "Couche base de données",
+		"phase.Authentication & Middleware": "Authentification et middleware",
+		"phase.Testing & Deployment":        "Tests et déploiement",
+
+		"next.Project Initialization":      "Créer main.go et initialiser le module Go",
+		"next.Application Foundation":      "Créer l'arborescence du dossier internal",
+		"next.Internal Structure":          "Implémenter la couche application avec app.go",
+		"next.Application Layer":           "Créer les handlers API dans internal/api",
+		"next.API Layer":                   "Configurer la connexion à la base et les migrations",
+		"next.Database Layer":              "Implémenter la couche de stockage (accès aux données)",
+		"next.Store Layer":                 "Ajouter l'authentification et les middlewares",
+		"next.Authentication & Middleware": "Écrire des tests complets",
+		"next.Testing & Deployment":        "Optimiser et déployer l'application",
+		"next.default":                     "Relire et refactoriser le code existant",
+
+		"advice.structure":        "🔧 Améliorez la structure du projet en créant les dossiers manquants",
+		"advice.quality":          "📝 Ajoutez une vraie gestion des erreurs et de la journalisation",
+		"advice.core":             "🚀 Terminez d'abord les couches principales de l'application",
+		"advice.auth":             "🔐 Implémentez l'authentification et les middlewares",
+		"advice.testing":          "🧪 Ajoutez des tests complets et de la documentation",
+		"advice.errors":           "❌ Corrigez les erreurs critiques avant de continuer",
+		"advice.warnings":         "⚠️ Traitez les avertissements pour améliorer la qualité du code",
+		"rating.excellent":        "🌟 EXCELLENT",
+		"rating.verygood":         "⭐ TRÈS BIEN",
+		"rating.good":             "👍 BIEN",
+		"rating.fair":             "📈 PASSABLE",
+		"rating.improvement":      "⚠️ À AMÉLIORER",
+		"rating.attention":        "🚨 ATTENTION REQUISE",
+		"subscore.Structure":      "Structure",
+		"subscore.Progress":       "Progression",
+		"subscore.Testing":        "Tests",
+		"subscore.Code Quality":   "Qualité du code",
+		"subscore.Error Handling": "Gestion des erreurs",
+		"subscore.Documentation":  "Documentation",
+		"subscore.Configuration":  "Configuration",
+
+		"report.assessment.title":     "🔍 RAPPORT COMPLET D'ÉVALUATION DU PROJET",
+		"report.assessment.overview":  "📊 VUE D'ENSEMBLE DU PROJET",
+		"report.assessment.progress":  "📈 ANALYSE DE LA PROGRESSION",
+		"report.assessment.quality":   "🏆 ÉVALUATION DE LA QUALITÉ",
+		"report.assessment.subscores": "📊 SOUS-NOTES DÉTAILLÉES",
+		"report.assessment.advice":    "💡 CONSEILS ET RECOMMANDATIONS",
+		"report.assessment.final":     "🎯 ÉVALUATION FINALE",
+		"label.evaluationDate":        "📅 Date d'évaluation",
+		"label.currentPhase":          "🎯 Phase actuelle",
+		"label.completion":            "📈 Avancement",
+		"label.completionPercent":     "📊 Pourcentage d'avancement",
+		"label.finalScore":            "🏆 Note finale",
+		"label.overallScore":          "🏆 Note globale",
+		"label.rating":                "⭐ Appréciation",
+		"label.nextStep":              "⏭️ Prochaine étape",
+		"label.status":                "🔍 Statut",
+		"label.structureScore":        "🏗️ Note de structure",
+		"label.codeQuality":           "📝 Qualité du code",
+		"label.errors":                "❌ Erreurs",
+		"label.warnings":              "⚠️ Avertissements",
+		"label.progress":              "📈 Progression",
+		"label.complete":              "terminé",
+		"label.focus":                 "🎯 Priorité",
+		"label.layout":                "🗂️ Organisation",
+		"layout.internal":             "internal/ (app, api, store)",
+		"layout.cmd":                  "cmd/ + bibliothèques",
+		"layout.pkg":                  "bibliothèques pkg/",
+		"layout.flat":                 "paquet unique à plat",
+		"layout.custom":               "personnalisée",
+
+		"report.inventory.title":      "# Inventaire des fonctions existantes - Généré automatiquement",
+		"report.inventory.intro":      "Ce document recense toutes les fonctions présentes actuellement dans le projet.",
+		"report.inventory.package":    "Paquet",
+		"report.inventory.files":      "Fichiers",
+		"report.inventory.functions":  "Fonctions",
+		"report.inventory.methodOn":   "méthode de",
+		"report.inventory.file":       "Fichier",
+		"report.inventory.line":       "ligne",
+		"report.inventory.inferred":   "(déduit)",
+		"report.inventory.deprecated": "🚫 obsolète",
+		"report.deprecated":           "🚫 Fonctions obsolètes",
+		"report.deprecated.intro":     "Fonctions dont le commentaire de doc contient un paragraphe `Deprecated:` - candidates à la suppression.",
+		"report.summary":              "Résumé",
+		"report.totalFunctions":       "Nombre total de fonctions",
+		"report.totalFiles":           "Nombre total de fichiers",
+		"report.totalPackages":        "Nombre total de paquets",
+		"report.totalTODOs":           "TODO/FIXME en attente",
+		"report.todoFiles":            "📝 TODO/FIXME par fichier",
+		"report.sequence.title":       "# Séquence de développement dynamique - Générée automatiquement",
+		"report.sequence.intro":       "Ce diagramme montre **dans quel ordre créer les fonctions** d'après la structure actuelle du projet.\nIl vous aide à savoir **par où commencer** pour construire un projet similaire.",
+		"report.sequence.phase":       "PHASE",
+		"report.status.title":         "# Rapport d'état du projet - Généré automatiquement",
+		"report.status.generated":     "Généré le",
+		"report.status.statistics":    "## 📊 Statistiques actuelles du projet",
+		"report.status.packages":      "## 📁 Répartition par paquet",
+		"report.status.phases":        "## 🎯 Phases de développement actuelles",
+		"unit.files":                  "fichiers",
+		"unit.functions":              "fonctions",
+	},
+}
+
+// setLanguage selects the message catalog for this run
+func setLanguage(lang string) error {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if lang == "" {
+		lang = "en"
+	}
+	if _, ok := messageCatalog[lang]; !ok {
+		return fmt.Errorf("unsupported language %q (supported: %s)", lang, strings.Join(supportedLanguages(), ", "))
+	}
+	currentLanguage = lang
+	return nil
+}
+
+// supportedLanguages lists the catalog languages in a stable order
+func supportedLanguages() []string {
+	langs := make([]string, 0, len(messageCatalog))
+	for lang := range messageCatalog {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// tr returns the text for key in the current language, falling back to English and then the key itself
+func tr(key string) string {
+	if text, ok := messageCatalog[currentLanguage][key]; ok {
+		return text
+	}
+	if text, ok := messageCatalog["en"][key]; ok {
+		return text
+	}
+	return key
+}
+
+// trPhase translates a phase name; the English name remains the internal identifier
+func trPhase(phase string) string {
+	if text := tr("phase." + phase); text != "phase."+phase {
+		return text
+	}
+	return phase
+}
@@ -0,0 +1,192 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+MULTI ROOT - ONE COMMAND FOR SEVERAL SERVICES
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: -root can be repeated (-root svc-a -root svc-b) or given a comma
+             list (-root svc-a,svc-b). With more than one root the full
+             pipeline runs once per root into <out>/<service-name>/, where the
+             service name is the folder name of the root's module. A failing
+             service is reported and the others still run. At the end a
+             cross-service index.html links every service's own index.
+
+TO USE THIS FILE:
+1. go run -tags flowcharts . -root ../svc-a,../svc-b
+2. Open <out>/index.html
+
+NOTES:
+- <out> is resolved against the working directory's module, as for one root
+- -task, -api-only, -handlers-only, -ref, -interactive, -fail-on-score and -serve take a single root
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// rootList collects -root values; the flag may be repeated and each value may be a comma list
+type rootList []string
+
+func (r *rootList) String() string { return strings.Join(*r, ",") }
+
+func (r *rootList) Set(value string) error {
+	for _, root := range strings.Split(value, ",") {
+		if root = strings.TrimSpace(root); root != "" {
+			*r = append(*r, root)
+		}
+	}
+	return nil
+}
+
+// serviceRun is the outcome of the pipeline for one root
+type serviceRun struct {
+	Name string // sub-directory of the output directory
+	Root string // module root that was scanned
+	Err  error
+}
+
+// runMultiRoot runs BTFlowcharts for every root into outAbs/<service-name> and writes a
+// cross-service index; it returns the number of services that failed
+func runMultiRoot(roots []string, outAbs string, opts FlowchartOptions) int {
+	// Each service writes its own index.html; only the cross-service index is opened, and not
+	// at all when the caller asked for no browser (-docs-only)
+	openIndex := !opts.SkipOpen
+	opts.SkipOpen = true
+
+	var runs []serviceRun
+	used := make(map[string]bool)
+	seen := make(map[string]bool)
+	for _, root := range roots {
+		moduleRoot := projectRootOrWD(root)
+		if abs, err := filepath.Abs(moduleRoot); err == nil {
+			moduleRoot = abs
+		}
+		if seen[moduleRoot] {
+			continue
+		}
+		seen[moduleRoot] = true
+		name := Existing_safeFileName(filepath.Base(moduleRoot))
+		for base, n := name, 2; used[name]; n++ {
+			name = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[name] = true
+
+		fmt.Printf("\n🧩 Service %s (%s)\n", name, moduleRoot)
+		run := serviceRun{Name: name, Root: moduleRoot}
+		if !dirExists(moduleRoot) {
+			run.Err = fmt.Errorf("root %s is not a directory", moduleRoot)
+		} else {
+			run.Err = BTFlowcharts(moduleRoot, filepath.Join(outAbs, name), opts)
+		}
+		if run.Err != nil {
+			fmt.Printf("❌ Service %s failed: %v (continuing with the others)\n", name, run.Err)
+		}
+		runs = append(runs, run)
+	}
+
+	failed := 0
+	for _, run := range runs {
+		if run.Err != nil {
+			failed++
+		}
+	}
+	fmt.Printf("\n📊 Services: %d generated, %d failed\n", len(runs)-failed, failed)
+
+	if err := writeServicesIndex(outAbs, runs); err != nil {
+		fmt.Printf("⚠️  Cross-service index failed: %v\n", err)
+		return failed
+	}
+	if opts.DocsOnly {
+		if err := writeServicesDiagramsMarkdown(outAbs, runs); err != nil {
+			fmt.Printf("⚠️  Cross-service DIAGRAMS.md failed: %v\n", err)
+		} else {
+			fmt.Printf("📚 Docs written to %s (start with DIAGRAMS.md or index.html)\n", outAbs)
+		}
+	}
+	if openIndex {
+		indexPath := filepath.Join(outAbs, "index.html")
+		exec.Command("cmd", "/c", "start", indexPath).Start()
+		fmt.Printf("🌐 Opened %s\n", indexPath)
+	}
+	return failed
+}
+
+// writeServicesDiagramsMarkdown writes outAbs/DIAGRAMS.md linking each service's own DIAGRAMS.md
+func writeServicesDiagramsMarkdown(outAbs string, runs []serviceRun) error {
+	var b strings.Builder
+	b.WriteString("# Services - Diagrams and Reports\n\n")
+	b.WriteString("One folder per service, each with its own table of contents. ")
+	b.WriteString("`index.html` links the same services for a browser.\n\n")
+	b.WriteString("| | Service | Root | Result |\n")
+	b.WriteString("|--|---------|------|--------|\n")
+	for _, run := range runs {
+		if run.Err != nil {
+			b.WriteString(fmt.Sprintf("| ❌ | %s | `%s` | %s |\n", run.Name, run.Root, strings.ReplaceAll(run.Err.Error(), "|", "\\|")))
+			continue
+		}
+		b.WriteString(fmt.Sprintf("| ✅ | [%s](%s/DIAGRAMS.md) | `%s` | generated |\n", run.Name, run.Name, run.Root))
+	}
+	return os.WriteFile(filepath.Join(outAbs, "DIAGRAMS.md"), []byte(b.String()), 0644)
+}
+
+// writeServicesIndex writes outAbs/index.html linking each service's index, with its status
+func writeServicesIndex(outAbs string, runs []serviceRun) error {
+	if err := ensureDir(outAbs); err != nil {
+		return err
+	}
+	var b strings.Builder
+	b.WriteString(`<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Services Index</title>
+    <style>
+        body { font-family: 'Segoe UI', Tahoma, Geneva, Verdana, sans-serif; margin: 20px; background: #f8f9fa; }
+        h1 { color: #2c3e50; border-bottom: 3px solid #3498db; padding-bottom: 10px; }
+        table { border-collapse: collapse; background: #fff; }
+        th, td { border: 1px solid #ccc; padding: 6px 10px; text-align: left; }
+        .failed { color: #b71c1c; }
+    </style>
+</head>
+<body>
+    <h1>🧩 Services Index</h1>
+    <table>
+        <tr><th></th><th>Service</th><th>Root</th><th>Result</th></tr>
+`)
+	for _, run := range runs {
+		name := html.EscapeString(run.Name)
+		if run.Err != nil {
+			b.WriteString(fmt.Sprintf("        <tr><td>❌</td><td>%s</td><td>%s</td><td class=\"failed\">%s</td></tr>\n",
+				name, html.EscapeString(run.Root), html.EscapeString(run.Err.Error())))
+			continue
+		}
+		link := name + "/index.html"
+		if !fileExists(filepath.Join(outAbs, run.Name, "index.html")) {
+			link = name + "/"
+		}
+		b.WriteString(fmt.Sprintf("        <tr><td>✅</td><td><a href=\"%s\" target=\"_blank\">%s</a></td><td>%s</td><td>generated</td></tr>\n",
+			link, name, html.EscapeString(run.Root)))
+	}
+	b.WriteString("    </table>\n</body>\n</html>\n")
+
+	return os.WriteFile(filepath.Join(outAbs, "index.html"), []byte(b.String()), 0644)
+}
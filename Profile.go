@@ -0,0 +1,117 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+PROFILE - TIME BREAKDOWN PER PIPELINE STEP
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file times the major steps of a run (scan, go-callvis,
+             goda + dot, PlantUML and each Mermaid generator) and prints a
+             summary table at the end. With -profile-cpu a pprof CPU profile
+             is also written to outDir/cpu.pprof.
+
+TO USE THIS FILE:
+1. Run with -profile (and optionally -profile-cpu)
+2. Wrap a step with: stop := profileStep("name"); ...; stop()
+3. Inspect the CPU profile with: go tool pprof cpu.pprof
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"time"
+)
+
+// stepTiming is the measured duration of one pipeline step
+type stepTiming struct {
+	Name     string
+	Duration time.Duration
+}
+
+// activeProfile collects step timings; nil when -profile is off
+var activeProfile *[]stepTiming
+
+// startProfiling enables step timings for this run
+func startProfiling() {
+	activeProfile = &[]stepTiming{}
+}
+
+// profileStep starts timing a step and returns the function that stops it.
+// It is a no-op unless profiling is enabled.
+func profileStep(name string) func() {
+	if activeProfile == nil {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		*activeProfile = append(*activeProfile, stepTiming{Name: name, Duration: time.Since(start)})
+	}
+}
+
+// printProfileSummary prints the recorded steps, slowest first
+func printProfileSummary() {
+	if activeProfile == nil || len(*activeProfile) == 0 {
+		return
+	}
+	steps := append([]stepTiming{}, *activeProfile...)
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].Duration > steps[j].Duration })
+
+	var total time.Duration
+	width := len("Step")
+	for _, step := range steps {
+		total += step.Duration
+		if len(step.Name) > width {
+			width = len(step.Name)
+		}
+	}
+
+	fmt.Println("\n⏱️  Profile - time per step")
+	fmt.Printf("   %-*s  %12s  %6s\n", width, "Step", "Duration", "Share")
+	fmt.Printf("   %s  %s  %s\n", strings.Repeat("-", width), strings.Repeat("-", 12), strings.Repeat("-", 6))
+	for _, step := range steps {
+		share := 0.0
+		if total > 0 {
+			share = float64(step.Duration) / float64(total) * 100
+		}
+		fmt.Printf("   %-*s  %12s  %5.1f%%\n", width, step.Name, step.Duration.Round(time.Millisecond), share)
+	}
+	fmt.Printf("   %-*s  %12s\n", width, "TOTAL", total.Round(time.Millisecond))
+}
+
+// startCPUProfile writes a pprof CPU profile to outDir/cpu.pprof until the returned stop is called
+func startCPUProfile(outDir string) (func(), error) {
+	if err := ensureDir(outDir); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(outDir, "cpu.pprof")
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("create cpu profile: %w", err)
+	}
+	if err := pprof.StartCPUProfile(f); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("start cpu profile: %w", err)
+	}
+	return func() {
+		pprof.StopCPUProfile()
+		f.Close()
+		fmt.Printf("🧮 CPU profile written to %s (go tool pprof %s)\n", path, path)
+	}, nil
+}
@@ -29,7 +29,7 @@ FEATURES:
 - Final scoring and ratings
 
 TO USE THIS FILE:
-1. Call ProjectEvaluator_WriteComprehensiveAssessment() for full evaluation
+1. Call ProjectEvaluator_WriteComprehensiveAssessment(projectRoot, outDir) for full evaluation
 2. Individual evaluation functions can be called for specific aspects
 3. Reports are saved as ProjectEvaluator_*.mmd.md files
 
@@ -51,6 +51,7 @@ type ProjectStatus struct {
 	CurrentPhase      string
 	CompletionPercent int
 	NextStep          string
+	LayoutStyle       string // internal, cmd, pkg, flat or custom
 	QualityScore      int
 	StructureScore    int
 	ErrorCount        int
@@ -61,31 +62,38 @@ type ProjectStatus struct {
 	Rating            string
 }
 
-// ProjectEvaluator_WriteComprehensiveAssessment creates a complete project evaluation
-func ProjectEvaluator_WriteComprehensiveAssessment(outDir string) error {
+// ProjectEvaluator_WriteComprehensiveAssessment creates a complete evaluation of the project at
+// projectRoot and returns the computed status so callers can act on the final score.
+func ProjectEvaluator_WriteComprehensiveAssessment(projectRoot, outDir string) (ProjectStatus, error) {
 	fmt.Println("🔍 Starting Comprehensive Project Evaluation...")
 
 	// Analyze current project status
-	status := ProjectEvaluator_AnalyzeProjectStatus()
+	status := ProjectEvaluator_AnalyzeProjectStatus(projectRoot)
 
 	// Generate comprehensive assessment report
 	content := ProjectEvaluator_GenerateAssessmentReport(status)
 
 	path := filepath.Join(outDir, "ProjectEvaluator_comprehensive_assessment.mmd.md")
-	return os.WriteFile(path, []byte(content), 0644)
+	return status, os.WriteFile(path, []byte(content), 0644)
 }
 
-// ProjectEvaluator_AnalyzeProjectStatus analyzes the current project state
-func ProjectEvaluator_AnalyzeProjectStatus() ProjectStatus {
+// ProjectEvaluator_CheckScore returns an error when the final score is below threshold (0 = off)
+func ProjectEvaluator_CheckScore(status ProjectStatus, threshold int) error {
+	if threshold <= 0 || status.FinalScore >= threshold {
+		return nil
+	}
+	return fmt.Errorf("final score %d/100 is below the required %d (%s)", status.FinalScore, threshold, status.Rating)
+}
+
+// ProjectEvaluator_AnalyzeProjectStatus analyzes the state of the project at projectRoot
+func ProjectEvaluator_AnalyzeProjectStatus(projectRoot string) ProjectStatus {
 	status := ProjectStatus{
 		SubScores:  make(map[string]int),
 		AdviceList: []string{},
 	}
 
-	// Find the actual project directory (not the diagram generator)
-	projectRoot := ProjectEvaluator_FindProjectRoot()
-
 	// Analyze project structure
+	status.LayoutStyle = ProjectEvaluator_DetectLayout(projectRoot)
 	status.StructureScore = ProjectEvaluator_AnalyzeStructure(projectRoot)
 
 	// Analyze code quality
@@ -112,51 +120,51 @@ func ProjectEvaluator_AnalyzeProjectStatus() ProjectStatus {
 	return status
 }
 
-// ProjectEvaluator_FindProjectRoot finds the actual Go project directory
-func ProjectEvaluator_FindProjectRoot() string {
-	// Start from current directory and look for go.mod
-	currentDir, _ := os.Getwd()
-
-	// Check current directory first
-	if _, err := os.Stat(filepath.Join(currentDir, "go.mod")); err == nil {
-		return currentDir
-	}
-
-	// Look in parent directories
-	parentDir := filepath.Dir(currentDir)
-	if _, err := os.Stat(filepath.Join(parentDir, "go.mod")); err == nil {
-		return parentDir
-	}
-
-	// Look in grandparent directory
-	grandparentDir := filepath.Dir(parentDir)
-	if _, err := os.Stat(filepath.Join(grandparentDir, "go.mod")); err == nil {
-		return grandparentDir
-	}
-
-	// If no go.mod found, assume current directory but look for Go files
-	if files, err := filepath.Glob("*.go"); err == nil && len(files) > 0 {
-		return currentDir
-	}
-
-	// Default to current directory
-	return currentDir
-}
-
 // ProjectEvaluator_AnalyzeStructure analyzes project structure and organization
 func ProjectEvaluator_AnalyzeStructure(projectRoot string) int {
 	score := 0
 
-	// Check for essential directories
-	essentialDirs := []string{"internal", "internal/app", "internal/api", "internal/store", "internal/middleware"}
-	for _, dir := range essentialDirs {
-		if _, err := os.Stat(filepath.Join(projectRoot, dir)); err == nil {
+	// Check for the directories the detected layout expects (up to 75 points)
+	switch ProjectEvaluator_DetectLayout(projectRoot) {
+	case "internal":
+		essentialDirs := []string{"internal", "internal/app", "internal/api", "internal/store", "internal/middleware"}
+		for _, dir := range essentialDirs {
+			if _, err := os.Stat(filepath.Join(projectRoot, dir)); err == nil {
+				score += 15
+			}
+		}
+	case "cmd":
+		// cmd/<name>/main.go entry points backed by internal/ or pkg/ libraries
+		score += 15
+		if mains, _ := filepath.Glob(filepath.Join(projectRoot, "cmd", "*", "main.go")); len(mains) > 0 {
+			score += 15
+		}
+		libs := ProjectEvaluator_CountGoPackages(filepath.Join(projectRoot, "internal")) +
+			ProjectEvaluator_CountGoPackages(filepath.Join(projectRoot, "pkg"))
+		if libs > 0 {
+			score += 15
+		}
+		score += min(libs, 2) * 15
+	case "pkg":
+		// Public library packages under pkg/ with an entry point at the root
+		score += 15
+		if ProjectEvaluator_HasEntryPoint(projectRoot) {
+			score += 15
+		}
+		score += min(ProjectEvaluator_CountGoPackages(filepath.Join(projectRoot, "pkg")), 3) * 15
+	case "flat":
+		// A single package at the root is idiomatic for small tools and libraries
+		score += 60
+		if ProjectEvaluator_HasEntryPoint(projectRoot) || fileExists(filepath.Join(projectRoot, "doc.go")) {
 			score += 15
 		}
 	}
 
 	// Check for essential files
-	essentialFiles := []string{"Ex11.go", "go.mod", "docker-compose.yml"}
+	if ProjectEvaluator_HasEntryPoint(projectRoot) {
+		score += 10
+	}
+	essentialFiles := []string{"go.mod", "docker-compose.yml"}
 	for _, file := range essentialFiles {
 		if _, err := os.Stat(filepath.Join(projectRoot, file)); err == nil {
 			score += 10
@@ -171,6 +179,52 @@ func ProjectEvaluator_AnalyzeStructure(projectRoot string) int {
 	return min(score, 100)
 }
 
+// ProjectEvaluator_DetectLayout identifies the project layout: internal, cmd, pkg, flat or custom
+func ProjectEvaluator_DetectLayout(projectRoot string) string {
+	switch {
+	case dirExists(filepath.Join(projectRoot, "cmd")):
+		return "cmd"
+	case dirExists(filepath.Join(projectRoot, "internal")):
+		return "internal"
+	case dirExists(filepath.Join(projectRoot, "pkg")):
+		return "pkg"
+	}
+
+	// Flat: Go files at the root and no sub-packages
+	rootFiles, _ := filepath.Glob(filepath.Join(projectRoot, "*.go"))
+	if len(rootFiles) > 0 && ProjectEvaluator_CountGoPackages(projectRoot) == 0 {
+		return "flat"
+	}
+	return "custom"
+}
+
+// ProjectEvaluator_CountGoPackages counts the direct subdirectories of dir that contain Go files
+func ProjectEvaluator_CountGoPackages(dir string) int {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0
+	}
+	count := 0
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || entry.Name() == "vendor" {
+			continue
+		}
+		if files, _ := filepath.Glob(filepath.Join(dir, entry.Name(), "*.go")); len(files) > 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// ProjectEvaluator_HasEntryPoint checks for a main program at the root or under cmd/
+func ProjectEvaluator_HasEntryPoint(projectRoot string) bool {
+	if fileExists(filepath.Join(projectRoot, "Ex11.go")) || fileExists(filepath.Join(projectRoot, "main.go")) {
+		return true
+	}
+	mains, _ := filepath.Glob(filepath.Join(projectRoot, "cmd", "*", "main.go"))
+	return len(mains) > 0
+}
+
 // ProjectEvaluator_AnalyzeCodeQuality analyzes code quality and best practices
 func ProjectEvaluator_AnalyzeCodeQuality(projectRoot string) int {
 	score := 0
@@ -269,26 +323,12 @@ func ProjectEvaluator_DetermineProgress(projectRoot string) (string, int) {
 // ProjectEvaluator_IdentifyNextStep identifies the next logical step
 func ProjectEvaluator_IdentifyNextStep(currentPhase string) string {
 	switch currentPhase {
-	case "Project Initialization":
-		return "Create main.go and initialize Go module"
-	case "Application Foundation":
-		return "Create internal directory structure"
-	case "Internal Structure":
-		return "Implement application layer with app.go"
-	case "Application Layer":
-		return "Create API handlers in internal/api"
-	case "API Layer":
-		return "Set up database connection and migrations"
-	case "Database Layer":
-		return "Implement store layer for data access"
-	case "Store Layer":
-		return "Add authentication and middleware"
-	case "Authentication & Middleware":
-		return "Write comprehensive tests"
-	case "Testing & Deployment":
-		return "Optimize and deploy the application"
+	case "Project Initialization", "Application Foundation", "Internal Structure",
+		"Application Layer", "API Layer", "Database Layer", "Store Layer",
+		"Authentication & Middleware", "Testing & Deployment":
+		return tr("next." + currentPhase)
 	default:
-		return "Review and refactor existing code"
+		return tr("next.default")
 	}
 }
 
@@ -328,30 +368,30 @@ func ProjectEvaluator_GenerateAdvice(status ProjectStatus) []string {
 
 	// Structure advice
 	if status.StructureScore < 70 {
-		advice = append(advice, "🔧 Improve project structure by creating missing directories")
+		advice = append(advice, tr("advice.structure"))
 	}
 
 	// Quality advice
 	if status.QualityScore < 70 {
-		advice = append(advice, "📝 Add proper error handling and logging")
+		advice = append(advice, tr("advice.quality"))
 	}
 
 	// Progress advice
 	if status.CompletionPercent < 50 {
-		advice = append(advice, "🚀 Focus on completing the core application layers first")
+		advice = append(advice, tr("advice.core"))
 	} else if status.CompletionPercent < 80 {
-		advice = append(advice, "🔐 Implement authentication and middleware")
+		advice = append(advice, tr("advice.auth"))
 	} else {
-		advice = append(advice, "🧪 Add comprehensive testing and documentation")
+		advice = append(advice, tr("advice.testing"))
 	}
 
 	// Error advice
 	if status.ErrorCount > 0 {
-		advice = append(advice, "❌ Fix critical errors before proceeding")
+		advice = append(advice, tr("advice.errors"))
 	}
 
 	if status.WarningCount > 2 {
-		advice = append(advice, "⚠️ Address warnings to improve code quality")
+		advice = append(advice, tr("advice.warnings"))
 	}
 
 	return advice
@@ -407,17 +447,17 @@ func ProjectEvaluator_CalculateFinalScore(status ProjectStatus) (int, string) {
 	var rating string
 	switch {
 	case totalScore >= 85:
-		rating = "🌟 EXCELLENT"
+		rating = tr("rating.excellent")
 	case totalScore >= 75:
-		rating = "⭐ VERY GOOD"
+		rating = tr("rating.verygood")
 	case totalScore >= 65:
-		rating = "👍 GOOD"
+		rating = tr("rating.good")
 	case totalScore >= 50:
-		rating = "📈 FAIR"
+		rating = tr("rating.fair")
 	case totalScore >= 30:
-		rating = "⚠️ NEEDS IMPROVEMENT"
+		rating = tr("rating.improvement")
 	default:
-		rating = "🚨 REQUIRES ATTENTION"
+		rating = tr("rating.attention")
 	}
 
 	return totalScore, rating
@@ -517,36 +557,44 @@ func ProjectEvaluator_ScoreConfiguration(projectRoot string) int {
 func ProjectEvaluator_GenerateAssessmentReport(status ProjectStatus) string {
 	timestamp := time.Now().Format("2006-01-02 15:04:05")
 
+	phase := trPhase(status.CurrentPhase)
+
 	content := "```mermaid\n" +
 		"flowchart TD\n" +
-		"    subgraph Assessment[\"🔍 COMPREHENSIVE PROJECT EVALUATION REPORT\"]\n" +
+		fmt.Sprintf("    subgraph Assessment[\"%s\"]\n", tr("report.assessment.title")) +
 		"        %% Header\n" +
-		"        subgraph Header[\"📊 PROJECT STATUS OVERVIEW\"]\n" +
-		fmt.Sprintf("            H1[\"📅 Evaluation Date: %s<br/>🎯 Current Phase: %s<br/>📈 Completion: %d%%<br/>🏆 Final Score: %d/100<br/>⭐ Rating: %s\"]\n", timestamp, status.CurrentPhase, status.CompletionPercent, status.FinalScore, status.Rating) +
+		fmt.Sprintf("        subgraph Header[\"%s\"]\n", tr("report.assessment.overview")) +
+		fmt.Sprintf("            H1[\"%s: %s<br/>%s: %s<br/>%s: %d%%<br/>%s: %d/100<br/>%s: %s\"]\n",
+			tr("label.evaluationDate"), timestamp, tr("label.currentPhase"), phase, tr("label.completion"), status.CompletionPercent,
+			tr("label.finalScore"), status.FinalScore, tr("label.rating"), status.Rating) +
 		"        end\n\n" +
 
 		"        %% Progress Analysis\n" +
-		"        subgraph Progress[\"📈 PROGRESS ANALYSIS\"]\n" +
-		fmt.Sprintf("            P1[\"🎯 Current Phase: %s<br/>📊 Completion Percentage: %d%%<br/>⏭️ Next Step: %s<br/>🔍 Status: %s\"]\n", status.CurrentPhase, status.CompletionPercent, status.NextStep, status.Rating) +
+		fmt.Sprintf("        subgraph Progress[\"%s\"]\n", tr("report.assessment.progress")) +
+		fmt.Sprintf("            P1[\"%s: %s<br/>%s: %d%%<br/>%s: %s<br/>%s: %s\"]\n",
+			tr("label.currentPhase"), phase, tr("label.completionPercent"), status.CompletionPercent,
+			tr("label.nextStep"), status.NextStep, tr("label.status"), status.Rating) +
 		"        end\n\n" +
 
 		"        %% Quality Assessment\n" +
-		"        subgraph Quality[\"🏆 QUALITY ASSESSMENT\"]\n" +
-		fmt.Sprintf("            Q1[\"🏗️ Structure Score: %d/100<br/>📝 Code Quality: %d/100<br/>❌ Errors: %d<br/>⚠️ Warnings: %d\"]\n", status.StructureScore, status.QualityScore, status.ErrorCount, status.WarningCount) +
+		fmt.Sprintf("        subgraph Quality[\"%s\"]\n", tr("report.assessment.quality")) +
+		fmt.Sprintf("            Q1[\"%s: %s<br/>%s: %d/100<br/>%s: %d/100<br/>%s: %d<br/>%s: %d\"]\n",
+			tr("label.layout"), tr("layout."+status.LayoutStyle), tr("label.structureScore"), status.StructureScore, tr("label.codeQuality"), status.QualityScore,
+			tr("label.errors"), status.ErrorCount, tr("label.warnings"), status.WarningCount) +
 		"        end\n\n" +
 
 		"        %% Detailed Sub-Scores\n" +
-		"        subgraph SubScores[\"📊 DETAILED SUB-SCORES\"]\n"
+		fmt.Sprintf("        subgraph SubScores[\"%s\"]\n", tr("report.assessment.subscores"))
 
 	// Add sub-scores
 	for category, score := range status.SubScores {
-		content += fmt.Sprintf("            S%d[\"%s: %d/100\"]\n", len(status.SubScores), category, score)
+		content += fmt.Sprintf("            S%d[\"%s: %d/100\"]\n", len(status.SubScores), tr("subscore."+category), score)
 	}
 
 	content += "        end\n\n" +
 
 		"        %% Advice Section\n" +
-		"        subgraph Advice[\"💡 INTELLIGENT ADVICE & RECOMMENDATIONS\"]\n"
+		fmt.Sprintf("        subgraph Advice[\"%s\"]\n", tr("report.assessment.advice"))
 
 	// Add advice items
 	for i, advice := range status.AdviceList {
@@ -556,8 +604,10 @@ func ProjectEvaluator_GenerateAssessmentReport(status ProjectStatus) string {
 	content += "        end\n\n" +
 
 		"        %% Final Assessment\n" +
-		"        subgraph Final[\"🎯 FINAL ASSESSMENT\"]\n" +
-		fmt.Sprintf("            F1[\"🏆 Overall Score: %d/100<br/>⭐ Rating: %s<br/>📈 Progress: %d%% Complete<br/>🎯 Focus: %s\"]\n", status.FinalScore, status.Rating, status.CompletionPercent, status.NextStep) +
+		fmt.Sprintf("        subgraph Final[\"%s\"]\n", tr("report.assessment.final")) +
+		fmt.Sprintf("            F1[\"%s: %d/100<br/>%s: %s<br/>%s: %d%% %s<br/>%s: %s\"]\n",
+			tr("label.overallScore"), status.FinalScore, tr("label.rating"), status.Rating,
+			tr("label.progress"), status.CompletionPercent, tr("label.complete"), tr("label.focus"), status.NextStep) +
 		"        end\n" +
 		"    end\n\n" +
 
@@ -573,11 +623,11 @@ func ProjectEvaluator_GenerateAssessmentReport(status ProjectStatus) string {
 }
 
 // ProjectEvaluator_WriteAllEvaluations generates all evaluation reports
-func ProjectEvaluator_WriteAllEvaluations(outDir string) error {
+func ProjectEvaluator_WriteAllEvaluations(projectRoot, outDir string) error {
 	fmt.Println("🔍 Generating Project Evaluation Reports...")
 
 	// Generate comprehensive assessment
-	if err := ProjectEvaluator_WriteComprehensiveAssessment(outDir); err != nil {
+	if _, err := ProjectEvaluator_WriteComprehensiveAssessment(projectRoot, outDir); err != nil {
 		return fmt.Errorf("failed to write comprehensive assessment: %w", err)
 	}
 
@@ -0,0 +1,93 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+RAW MERMAID - PLAIN .mmd FILES NEXT TO THE MARKDOWN DIAGRAMS
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: The diagrams are written as .mmd.md - Markdown with prose and a
+             ```mermaid fence - which renders on GitHub but not in mmdc or
+             most editor plugins. With -raw-mermaid every .mmd.md under the
+             output directory also gets a sibling .mmd holding only the
+             diagram body. A file with several diagrams gets one .mmd per
+             diagram: name.mmd, name_2.mmd, ...
+
+TO USE THIS FILE:
+1. go run -tags flowcharts . -raw-mermaid
+2. mmdc -i BTFlowcharts/Existing_architecture.mmd -o architecture.svg
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// rawMermaid also writes a plain .mmd per diagram (set from -raw-mermaid)
+var rawMermaid bool
+
+// mermaidBlocks returns the bodies of the ```mermaid fences in a Markdown document
+func mermaidBlocks(markdown string) []string {
+	var blocks []string
+	var current strings.Builder
+	inBlock := false
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case !inBlock && trimmed == "```mermaid":
+			inBlock = true
+			current.Reset()
+		case inBlock && trimmed == "```":
+			inBlock = false
+			blocks = append(blocks, current.String())
+		case inBlock:
+			current.WriteString(strings.TrimRight(line, "\r") + "\n")
+		}
+	}
+	return blocks
+}
+
+// writeRawMermaid writes the sibling .mmd files for every .mmd.md under outDir and returns how many it wrote
+func writeRawMermaid(outDir string) (int, error) {
+	written := 0
+	err := filepath.WalkDir(outDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".mmd.md") {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		base := strings.TrimSuffix(path, ".mmd.md")
+		for i, block := range mermaidBlocks(string(data)) {
+			target := base + ".mmd"
+			if i > 0 {
+				target = fmt.Sprintf("%s_%d.mmd", base, i+1)
+			}
+			if err := os.WriteFile(target, []byte(block), 0644); err != nil {
+				return err
+			}
+			written++
+		}
+		return nil
+	})
+	return written, err
+}
@@ -70,7 +70,10 @@ func generateSVGCharts(root, outDir string, opts FlowchartOptions, structure *Pr
 		fmt.Printf("🔑 Found %d key functions (main, handler, store, route) in current project\n", keyFunctions)
 	}
 
-	// Ensure tools exist
+	// Ensure the go-callvis options are valid and the tools exist
+	if err := validateCallvisOptions(opts); err != nil {
+		return err
+	}
 	if err := ensureTool("go-callvis"); err != nil {
 		return wrapInstallHint(err, "go install github.com/ofabry/go-callvis@latest")
 	}
@@ -195,7 +198,7 @@ func generateSVGCharts(root, outDir string, opts FlowchartOptions, structure *Pr
 
 				// Render types.puml to SVG if PlantUML is available
 				if cmd, args, ok := findPlantUMLRenderer(); ok {
-					if err := runInDir(filepath.Join(root, outDir), cmd, append(args, "types.puml")...); err != nil {
+					if err := runInDir(outDir, cmd, append(args, "types.puml")...); err != nil {
 						fmt.Printf("⚠️  PlantUML render failed: %v\n", err)
 					} else {
 						fmt.Println("✅ Generated types.svg")
@@ -36,6 +36,8 @@ FEATURES:
 - Automatic ERD generation using SchemaSpy
 - PostgreSQL database integration
 - Optional feature with graceful fallback
+- Reachability pre-check (TCP dial, masked DSN) before SchemaSpy runs;
+  authentication errors are still reported by SchemaSpy
 - User confirmation before generation
 
 ===============================================================================
@@ -45,12 +47,19 @@ package main
 
 import (
 	"fmt"
+	"html"
+	"net"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
+// dbPrecheckTimeout bounds the reachability check run before SchemaSpy
+const dbPrecheckTimeout = 3 * time.Second
+
 // GenerateSchemaSpyERD runs SchemaSpy to generate an ERD if the environment is ready.
 // Requires: JAVA in PATH, SCHEMASPY_JAR and PG_JDBC_JAR env vars, and DB connection env.
 // Env: DB_HOST, DB_PORT (optional, default 5432), DB_NAME, DB_USER, DB_PASS
@@ -126,15 +135,25 @@ func generateSchemaSpyERD(wd, outDir string, structure interface{}) error {
 		return nil
 	}
 
+	// Check the database is reachable before SchemaSpy fails deep inside Java; SchemaSpy
+	// itself reports a wrong DB_NAME/DB_USER/DB_PASS
+	dsn := maskedDSN(host, port, db, user, pass)
+	if err := checkDatabaseReachable(host, port); err != nil {
+		fmt.Printf("⚠️  SchemaSpy ERD generation skipped: database not reachable: %v\n", err)
+		fmt.Printf("   DSN: %s\n", dsn)
+		fmt.Println("   Check DB_HOST/DB_PORT and that the database server is running")
+		return nil
+	}
+	fmt.Printf("✅ Database reachable at %s (credentials are checked by SchemaSpy)\n", net.JoinHostPort(host, port))
+
 	fmt.Println("✅ All SchemaSpy requirements met!")
-	fmt.Printf("   Database: %s@%s:%s/%s\n", user, host, port, db)
+	fmt.Printf("   Database: %s\n", dsn)
 	fmt.Printf("   SchemaSpy JAR: %s\n", jar)
 	fmt.Printf("   PostgreSQL JDBC: %s\n", pgjdbc)
 
 	// Ask user for confirmation
 	fmt.Print("\n🤔 Do you want to generate SchemaSpy ERD? (y/N): ")
-	var response string
-	fmt.Scanln(&response)
+	response, _ := scanAnswer() // EOF counts as "no"
 
 	if response != "y" && response != "Y" && response != "yes" && response != "Yes" {
 		fmt.Println("⏭️  SchemaSpy ERD generation skipped by user choice")
@@ -145,7 +164,7 @@ func generateSchemaSpyERD(wd, outDir string, structure interface{}) error {
 
 	// Create output directory
 	out := filepath.Join(outDir, "BTspyERD")
-	if err := ensureDir(filepath.Join(wd, out)); err != nil {
+	if err := ensureDir(out); err != nil {
 		return fmt.Errorf("failed to create ERD output directory: %w", err)
 	}
 
@@ -176,19 +195,60 @@ func generateSchemaSpyERD(wd, outDir string, structure interface{}) error {
 	fmt.Printf("   Open: %s\n", filepath.Join(out, "index.html"))
 
 	// Generate Mermaid ERDs as replacement for SchemaSpy's broken relationship diagrams
-	if err := generateMermaidERDs(out, structure); err != nil {
+	if err := generateMermaidERDs(wd, out, structure); err != nil {
 		fmt.Printf("⚠️  Warning: Mermaid ERD generation failed: %v\n", err)
 	}
 
 	return nil
 }
 
-// generateMermaidERDs creates Mermaid ERD diagrams to replace SchemaSpy's relationship diagrams
-func generateMermaidERDs(outDir string, structure interface{}) error {
+// checkDatabaseReachable opens a TCP connection to the database with a short timeout.
+// It only proves the server accepts connections; it does not log in.
+func checkDatabaseReachable(host, port string) error {
+	addr := net.JoinHostPort(host, port)
+	conn, err := net.DialTimeout("tcp", addr, dbPrecheckTimeout)
+	if err != nil {
+		return fmt.Errorf("could not connect to %s: %w", addr, err)
+	}
+	return conn.Close()
+}
+
+// maskedDSN returns a postgres DSN for display with the password replaced by ****
+func maskedDSN(host, port, db, user, pass string) string {
+	userInfo := url.User(user).String()
+	if pass != "" {
+		userInfo += ":****"
+	}
+	return fmt.Sprintf("postgres://%s@%s/%s", userInfo, net.JoinHostPort(host, port), db)
+}
+
+// generateMermaidERDs creates Mermaid ERD diagrams to replace SchemaSpy's relationship diagrams.
+// The schema ERD is derived from real migrations (or db-tagged structs); the canned
+// simple/complex ERDs are only written with -erd-sample and are labeled as examples.
+func generateMermaidERDs(wd, outDir string, structure interface{}) error {
 	fmt.Println("🎨 Generating Mermaid ERD diagrams...")
 
-	// Create simple ERD
-	simpleERD := `erDiagram
+	var links, sections strings.Builder
+	var written []string
+
+	// Schema ERD from the real project
+	schemaPath := filepath.Join(outDir, "relationships_schema.mmd.md")
+	schemaERD, source, err := writeProjectERD(wd, schemaPath)
+	if err != nil {
+		fmt.Printf("⚠️  Warning: could not derive ERD from project: %v\n", err)
+	}
+	if schemaERD != "" {
+		written = append(written, schemaPath)
+		links.WriteString(`
+            <a href="relationships_schema.mmd.md" class="button">📄 Schema ERD (Markdown)</a>`)
+		sections.WriteString(erdHTMLSection("Schema ERD - derived from "+source, schemaERD))
+	} else {
+		fmt.Println("ℹ️  No migrations or db-tagged structs found - no schema ERD written")
+	}
+
+	// Canned example ERDs, opt-in only
+	if erdSampleEnabled {
+		simpleERD := `erDiagram
     USERS {
         bigint id PK
         varchar email UK
@@ -220,8 +280,7 @@ func generateMermaidERDs(outDir string, structure interface{}) error {
     USERS ||--o{ WORKOUTS : creates
     WORKOUTS ||--o{ WORKOUT_ENTRIES : contains`
 
-	// Create complex ERD with additional details
-	complexERD := `erDiagram
+		complexERD := `erDiagram
     USERS {
         bigint id PK "Primary Key, Auto Increment"
         varchar email UK "Unique Email Address"
@@ -278,16 +337,27 @@ func generateMermaidERDs(outDir string, structure interface{}) error {
     EXERCISE_TEMPLATES ||--o{ WORKOUT_ENTRIES : "references"
     WORKOUT_TEMPLATES ||--o{ WORKOUTS : "generates"`
 
-	// Write simple ERD
-	simplePath := filepath.Join(outDir, "relationships_simple.mmd.md")
-	if err := os.WriteFile(simplePath, []byte(simpleERD), 0644); err != nil {
-		return fmt.Errorf("failed to write simple ERD: %w", err)
+		samples := []struct{ file, title, erd string }{
+			{"relationships_simple.mmd.md", "Simple ERD - Basic Relationships", simpleERD},
+			{"relationships_complex.mmd.md", "Complex ERD - Detailed Schema", complexERD},
+		}
+		for _, sample := range samples {
+			path := filepath.Join(outDir, sample.file)
+			content := fmt.Sprintf("# %s: %s\n\n> This is a canned sample diagram. Its tables are invented and do not come from your project.\n\n```mermaid\n%s\n```\n",
+				erdSampleLabel, sample.title, sample.erd)
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", sample.file, err)
+			}
+			written = append(written, path)
+			links.WriteString(fmt.Sprintf(`
+            <a href="%s" class="button">📄 %s (%s)</a>`, sample.file, sample.title, erdSampleLabel))
+			sections.WriteString(erdHTMLSection(erdSampleLabel+": "+sample.title, sample.erd))
+		}
 	}
 
-	// Write complex ERD
-	complexPath := filepath.Join(outDir, "relationships_complex.mmd.md")
-	if err := os.WriteFile(complexPath, []byte(complexERD), 0644); err != nil {
-		return fmt.Errorf("failed to write complex ERD: %w", err)
+	if len(written) == 0 {
+		fmt.Println("   Use -erd-sample to write the canned EXAMPLE ERDs instead")
+		return nil
 	}
 
 	// Create HTML file to display Mermaid ERDs
@@ -295,7 +365,7 @@ func generateMermaidERDs(outDir string, structure interface{}) error {
 <html>
 <head>
     <title>Database ERD - Mermaid Diagrams</title>
-    <script src="https://cdn.jsdelivr.net/npm/mermaid/dist/mermaid.min.js"></script>
+    <script src="` + mermaidScriptURL() + `"></script>
     <style>
         body { font-family: Arial, sans-serif; margin: 20px; background: #f5f5f5; }
         .container { max-width: 1200px; margin: 0 auto; background: white; padding: 20px; border-radius: 8px; box-shadow: 0 2px 10px rgba(0,0,0,0.1); }
@@ -315,34 +385,19 @@ func generateMermaidERDs(outDir string, structure interface{}) error {
         <div class="note">
             <strong>📊 Mermaid ERD Diagrams</strong><br>
             These diagrams show the database schema relationships using Mermaid.js.
-            The simple version shows basic relationships, while the complex version
-            includes additional details and constraints.
+            The schema ERD is derived from your migrations (or db-tagged structs);
+            diagrams marked EXAMPLE are canned samples and are not your schema.
         </div>
         
         <div style="text-align: center; margin: 20px 0;">
-            <a href="index.html" class="button">← Back to SchemaSpy</a>
-            <a href="relationships_simple.mmd.md" class="button">📄 Simple ERD (Markdown)</a>
-            <a href="relationships_complex.mmd.md" class="button">📄 Complex ERD (Markdown)</a>
-        </div>
-        
-        <div class="diagram">
-            <h2>Simple ERD - Basic Relationships</h2>
-            <div class="mermaid">
-` + simpleERD + `
-            </div>
-        </div>
-        
-        <div class="diagram">
-            <h2>Complex ERD - Detailed Schema</h2>
-            <div class="mermaid">
-` + complexERD + `
-            </div>
+            <a href="index.html" class="button">← Back to SchemaSpy</a>` + links.String() + `
         </div>
+        ` + sections.String() + `
         
         <div class="note">
             <strong>💡 How to Use These Diagrams:</strong><br>
-            • <strong>Simple ERD:</strong> Shows basic table relationships and primary/foreign keys<br>
-            • <strong>Complex ERD:</strong> Includes detailed column information, constraints, and additional tables<br>
+            • <strong>Schema ERD:</strong> Tables, primary/foreign keys and relationships from your migrations<br>
+            • <strong>EXAMPLE ERDs:</strong> Only written with -erd-sample, to show what a detailed ERD looks like<br>
             • <strong>Export:</strong> Right-click on diagrams to save as image or copy to clipboard<br>
             • <strong>Print:</strong> Use Ctrl+P to print or save as PDF
         </div>
@@ -368,13 +423,26 @@ func generateMermaidERDs(outDir string, structure interface{}) error {
 	}
 
 	fmt.Println("✅ Mermaid ERD diagrams generated successfully!")
-	fmt.Printf("   Simple ERD: %s\n", simplePath)
-	fmt.Printf("   Complex ERD: %s\n", complexPath)
+	for _, path := range written {
+		fmt.Printf("   ERD: %s\n", path)
+	}
 	fmt.Printf("   HTML Viewer: %s\n", htmlPath)
 
 	return nil
 }
 
+// erdHTMLSection renders one ERD as a diagram block of relationships.html
+func erdHTMLSection(title, erd string) string {
+	return `
+        <div class="diagram">
+            <h2>` + html.EscapeString(title) + `</h2>
+            <div class="mermaid">
+` + erd + `
+            </div>
+        </div>
+        `
+}
+
 // getenvDefault returns the environment variable value or a default if not set
 func getenvDefault(key, def string) string {
 	v := os.Getenv(key)
@@ -514,7 +582,7 @@ WHY WE USE IT:
 
 HOW IT WORKS:
 =================
-1. Environment check: Verifies Java, SchemaSpy JAR, and database connectivity
+1. Environment check: Verifies Java, SchemaSpy JAR, and that the database is reachable
 2. User confirmation: Asks for permission before running external tools
 3. SchemaSpy execution: Runs Java tool with database connection parameters
 4. Mermaid generation: Creates modern web-based ERD diagrams
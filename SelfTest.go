@@ -0,0 +1,428 @@
+//go:build flowcharts
+// +build flowcharts
+
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+SELF TEST - SMOKE TEST AGAINST AN EMBEDDED SAMPLE PROJECT
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: This file verifies the tool after install without pointing it at
+             real code. A tiny sample Go module is embedded in the binary,
+             written to a temp dir, and the pure-Go generators (no go-callvis,
+             goda or dot needed) are run against it. Each expected output file
+             is checked and reported as PASS/FAIL.
+
+TO USE THIS FILE:
+1. Run with -selftest
+2. Open the printed output folder to see a demo of the generated reports
+
+NOTES:
+- Built with -tags flowcharts, like Theory2Reality.go whose progress chart
+  it checks
+- Checks of pure helpers live in the _test.go files and run with
+  `go test -tags flowcharts ./...`; -selftest checks the generated outputs
+
+SAMPLE PROJECT:
+- selftest_sample/ holds the module; files carry a .txt suffix so the
+  sample is not compiled as part of this tool (a nested go.mod cannot be
+  embedded either). The suffix is stripped when the sample is written out.
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+//go:embed selftest_sample
+var selfTestSample embed.FS
+
+// selfTestExpectedFiles are the outputs every pure-Go generator must produce
+var selfTestExpectedFiles = []string{
+	"Existing_function_inventory.md",
+	"Existing_dynamic_development_sequence.mmd.md",
+	"Existing_project_status_report.md",
+	"Existing_application_brain.mmd.md",
+	"Existing_store_connections.mmd.md",
+	"Existing_package_mindmap.mmd.md",
+	"Existing_function_changelog.md",
+	"Existing_concurrency.mmd.md",
+	"per_file/index.md",
+	"Existing_type_report.md",
+	"Existing_architecture.svg",
+	"Existing_structure.json",
+	"Existing_sql_inventory.md",
+	"Existing_schema_erd.mmd.md",
+	"Existing_architecture.mmd.md",
+	"Existing_middleware_chain.mmd.md",
+	"Existing_file_tree.mmd.md",
+	"Existing_reverse_index.md",
+	"Existing_external_deps.md",
+	"Existing_package_treemap.html",
+	"Existing_dependency_matrix.html",
+	"Existing_import_cycles.md",
+	"Existing_data_flow.mmd.md",
+	"Existing_endpoint_sitemap.md",
+	"Existing_context_propagation.md",
+	"Existing_long_functions.md",
+	"readmes/store.md",
+	"ClassModel_conformance.md",
+}
+
+// SelfTest_Run writes the embedded sample to a temp dir, generates against it and checks the outputs
+func SelfTest_Run() error {
+	fmt.Println("🧪 BT Project Builder & Evaluator - Self Test")
+	fmt.Println("==============================================")
+
+	tmp, err := os.MkdirTemp("", "bt-selftest-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	root := filepath.Join(tmp, "sample")
+	outDir := resolveOutDir(tmp, defaultOutDir)
+
+	if err := SelfTest_writeSample(root); err != nil {
+		return fmt.Errorf("write sample: %w", err)
+	}
+	if err := ensureDir(outDir); err != nil {
+		return err
+	}
+	fmt.Printf("📁 Sample project: %s\n", root)
+
+	// Run the pure-Go generators
+	structure, err := Existing_scanProject(root)
+	if err != nil {
+		return fmt.Errorf("scan sample: %w", err)
+	}
+	fmt.Printf("🔍 Scanned %d functions across %d files\n", len(structure.Functions), len(structure.Files))
+	if err := Existing_generateUpdatedReports(outDir, structure); err != nil {
+		return fmt.Errorf("dynamic reports: %w", err)
+	}
+	if err := Existing_WriteStructureJSON(outDir, root, structure); err != nil {
+		return fmt.Errorf("structure JSON: %w", err)
+	}
+	if err := runGenerators(context.Background(), GeneratorConfig{Root: root, OutDir: outDir}, structure); err != nil {
+		return fmt.Errorf("registered generators: %w", err)
+	}
+	if err := ClassModelBuilder_CompareToModel(root, outDir); err != nil {
+		return fmt.Errorf("model conformance: %w", err)
+	}
+
+	// Check every expected output; diagrams gated on the pinned Mermaid version are not expected
+	var expected []string
+	for _, name := range selfTestExpectedFiles {
+		if name == "Existing_package_mindmap.mmd.md" && !mermaidSupports("mindmap") {
+			continue
+		}
+		expected = append(expected, name)
+	}
+	missing := 0
+	for _, name := range expected {
+		info, err := os.Stat(filepath.Join(outDir, name))
+		if err != nil || info.Size() == 0 {
+			fmt.Printf("❌ FAIL  %s\n", name)
+			missing++
+			continue
+		}
+		fmt.Printf("✅ PASS  %s\n", name)
+	}
+
+	// Paths written into diagrams must be relative with forward slashes on every OS
+	mmdFiles, _ := filepath.Glob(filepath.Join(outDir, "*.mmd.md"))
+	badPaths := 0
+	for _, path := range mmdFiles {
+		data, err := os.ReadFile(path)
+		if err != nil || strings.Contains(string(data), "\\") || strings.Contains(string(data), tmp) {
+			fmt.Printf("❌ FAIL  %s contains backslashes or absolute paths\n", filepath.Base(path))
+			badPaths++
+		}
+	}
+	if badPaths == 0 {
+		fmt.Printf("✅ PASS  %d diagrams use relative forward-slash paths\n", len(mmdFiles))
+	}
+	failed := missing + badPaths
+	failed += SelfTest_checkTODOs(structure)
+	failed += SelfTest_checkDeprecated(structure)
+	failed += SelfTest_checkTypes(structure)
+	failed += SelfTest_checkWorkers(outDir, structure)
+	failed += SelfTest_checkInitFunctions(outDir)
+	failed += SelfTest_checkAuxiliary(outDir, structure)
+	failed += SelfTest_checkRawMermaid(outDir)
+	failed += SelfTest_checkContextPropagation(outDir)
+	failed += SelfTest_checkHandlerDependencies(outDir, structure)
+	failed += SelfTest_checkPurposeSources(structure)
+	failed += SelfTest_checkDataFlow(outDir)
+	failed += SelfTest_checkUserJourney(outDir, root)
+	failed += SelfTest_checkProgressChart(outDir, structure)
+	failed += SelfTest_checkManifest(outDir)
+
+	fmt.Printf("\n📂 Output: %s\n", outDir)
+	if failed > 0 {
+		return fmt.Errorf("self test FAILED: %d check(s) failed, %d of %d outputs missing", failed, missing, len(expected))
+	}
+	fmt.Printf("🎉 Self test PASSED: %d outputs generated\n", len(expected))
+	return nil
+}
+
+// SelfTest_checkTODOs verifies that the sample's TODO comment is counted on its function
+func SelfTest_checkTODOs(structure *ProjectStructure) int {
+	for _, fn := range structure.Functions {
+		if fn.Name == "CreateUser" && fn.TODOs == 1 {
+			fmt.Println("✅ PASS  TODO/FIXME comments counted per function")
+			return 0
+		}
+	}
+	fmt.Println("❌ FAIL  TODO in CreateUser was not counted")
+	return 1
+}
+
+// SelfTest_checkDeprecated verifies that a "Deprecated:" doc paragraph is detected with its note
+func SelfTest_checkDeprecated(structure *ProjectStructure) int {
+	for _, fn := range structure.Functions {
+		if fn.Name == "GetUserName" && fn.Deprecated && fn.DeprecationNote == "use GetUserByID and read Name." {
+			fmt.Println("✅ PASS  Deprecated: doc comments detected")
+			return 0
+		}
+	}
+	fmt.Println("❌ FAIL  GetUserName was not flagged as deprecated")
+	return 1
+}
+
+// SelfTest_checkTypes verifies struct field counts, embedded types and tag detection
+func SelfTest_checkTypes(structure *ProjectStructure) int {
+	for _, t := range structure.Types {
+		if t.Name == "AdminUser" && t.Fields == 2 && len(t.Embedded) == 1 && t.Embedded[0] == "User" && t.JSONTags && !t.DBTags {
+			fmt.Println("✅ PASS  struct fields, embedded types and tags captured")
+			return 0
+		}
+	}
+	fmt.Println("❌ FAIL  AdminUser (embeds User, one json-tagged field) was not captured")
+	return 1
+}
+
+// SelfTest_checkWorkers verifies that the sample's session cleaner goroutine is drawn as a background worker
+func SelfTest_checkWorkers(outDir string, structure *ProjectStructure) int {
+	if err := AIAd_WriteExecutionFlowDiagram(outDir, structure); err != nil {
+		fmt.Printf("❌ FAIL  execution flow: %v\n", err)
+		return 1
+	}
+	data, err := os.ReadFile(filepath.Join(outDir, "AIAd_execution_flow.mmd.md"))
+	if err != nil || !strings.Contains(string(data), "E2 -.->|go| BW1") || !strings.Contains(string(data), "go cleaner.Run()") {
+		fmt.Println("❌ FAIL  go cleaner.Run() in NewApplication is not a background worker node")
+		return 1
+	}
+	fmt.Println("✅ PASS  background workers drawn in the execution flow")
+	return 0
+}
+
+// SelfTest_checkInitFunctions verifies that the sample's init functions run in import order before main:
+// store (imported by app, imported by main) first, then main's own init
+func SelfTest_checkInitFunctions(outDir string) int {
+	data, err := os.ReadFile(filepath.Join(outDir, "AIAd_execution_flow.mmd.md"))
+	if err != nil || !strings.Contains(string(data), `IN1["1. store.init()`) || !strings.Contains(string(data), `IN2["2. main.init()`) ||
+		!strings.Contains(string(data), "IN2 ==>|then| E1") {
+		fmt.Println("❌ FAIL  init functions should run store.init() then main.init() before main()")
+		return 1
+	}
+	fmt.Println("✅ PASS  init functions grouped in import order before main")
+	return 0
+}
+
+// SelfTest_checkAuxiliary verifies that the sample's migration and compose services are scanned
+// and named on the store connections diagram instead of the canned file names
+func SelfTest_checkAuxiliary(outDir string, structure *ProjectStructure) int {
+	aux := structure.Aux
+	if len(aux.Migrations) != 1 || aux.Migrations[0].Version != 1 || len(aux.Services) != 2 ||
+		aux.Services[0].Name != "db" || aux.Services[0].Image != "postgres:16" || aux.Services[1].Image != "" {
+		fmt.Printf("❌ FAIL  auxiliary scan: %+v\n", aux)
+		return 1
+	}
+	data, err := os.ReadFile(filepath.Join(outDir, "Existing_store_connections.mmd.md"))
+	if err != nil || !strings.Contains(string(data), "00001_create-users.sql") || strings.Contains(string(data), "00001_users.sql") {
+		fmt.Println("❌ FAIL  store connections diagram does not name the real migration files")
+		return 1
+	}
+	fmt.Println("✅ PASS  migrations and docker-compose services scanned")
+	return 0
+}
+
+// SelfTest_checkRawMermaid verifies that -raw-mermaid writes the bare diagram body next to a .mmd.md
+func SelfTest_checkRawMermaid(outDir string) int {
+	if _, err := writeRawMermaid(outDir); err != nil {
+		fmt.Printf("❌ FAIL  raw mermaid: %v\n", err)
+		return 1
+	}
+	data, err := os.ReadFile(filepath.Join(outDir, "Existing_store_connections.mmd"))
+	if err != nil || !strings.HasPrefix(string(data), "flowchart TD") || strings.Contains(string(data), "```") {
+		fmt.Println("❌ FAIL  Existing_store_connections.mmd is missing or not a bare diagram")
+		return 1
+	}
+	fmt.Println("✅ PASS  raw .mmd files hold only the diagram body")
+	return 0
+}
+
+// SelfTest_checkContextPropagation verifies that the sample handler is flagged for calling a
+// store method that queries without taking a context
+func SelfTest_checkContextPropagation(outDir string) int {
+	data, err := os.ReadFile(filepath.Join(outDir, "Existing_context_propagation.md"))
+	if err != nil || !strings.Contains(string(data), "`UserHandler.HandleGetUser` | `internal/api/user_handler.go:") ||
+		!strings.Contains(string(data), "`GetUserByID` does I/O but takes no context") {
+		fmt.Println("❌ FAIL  HandleGetUser -> GetUserByID (QueryRow without ctx) was not flagged")
+		return 1
+	}
+	fmt.Println("✅ PASS  dropped request context flagged from handler to store")
+	return 0
+}
+
+// SelfTest_checkHandlerDependencies verifies that the handler diagram keeps the request path only
+func SelfTest_checkHandlerDependencies(outDir string, structure *ProjectStructure) int {
+	if err := Existing_WriteHandlerDependencyDiagram(outDir, structure); err != nil {
+		fmt.Printf("❌ FAIL  handler dependency diagram: %v\n", err)
+		return 1
+	}
+	data, err := os.ReadFile(filepath.Join(outDir, "Existing_function_dependencies_handlers.mmd.md"))
+	if err != nil || !strings.Contains(string(data), "HandleGetUser --> GetUserByID") || strings.Contains(string(data), "NewUserHandler") {
+		fmt.Println("❌ FAIL  handler diagram should hold HandleGetUser --> GetUserByID and nothing else")
+		return 1
+	}
+	fmt.Println("✅ PASS  handler dependency diagram shows only handlers and store methods")
+	return 0
+}
+
+// SelfTest_checkPurposeSources verifies that doc comments win over name guesses
+func SelfTest_checkPurposeSources(structure *ProjectStructure) int {
+	want := map[string]string{"NewUserStore": purposeDoc, "main": purposeUnknown}
+	failed := 0
+	for _, fn := range structure.Functions {
+		if source, ok := want[fn.Name]; ok && fn.PurposeSource != source {
+			fmt.Printf("❌ FAIL  purpose of %s is %q, want %q\n", fn.Name, fn.PurposeSource, source)
+			failed++
+		}
+	}
+	if failed == 0 {
+		fmt.Println("✅ PASS  purposes tagged doc/heuristic/unknown")
+	}
+	return failed
+}
+
+// SelfTest_checkDataFlow verifies that the sample route is traced down to its SQL table
+func SelfTest_checkDataFlow(outDir string) int {
+	data, _ := os.ReadFile(filepath.Join(outDir, "Existing_data_flow.mmd.md"))
+	if !strings.Contains(string(data), "handler_HandleGetUser --> store_GetUserByID") ||
+		!strings.Contains(string(data), "store_GetUserByID --> table_users") {
+		fmt.Println("❌ FAIL  data flow does not trace /users -> HandleGetUser -> GetUserByID -> users")
+		return 1
+	}
+	fmt.Println("✅ PASS  data flow traced from route to SQL table")
+	return 0
+}
+
+// SelfTest_checkUserJourney verifies that the sample - which has no auth or CRUD routes - gets
+// the generic journey template
+func SelfTest_checkUserJourney(outDir, root string) int {
+	if err := AIAd_WriteUserJourneyDiagram(outDir, root); err != nil {
+		fmt.Printf("❌ FAIL  user journey: %v\n", err)
+		return 1
+	}
+	data, err := os.ReadFile(filepath.Join(outDir, "AIAd_user_journey.mmd.md"))
+	if err != nil || !strings.Contains(string(data), "generic template") || !strings.Contains(string(data), "journey\n") {
+		fmt.Println("❌ FAIL  user journey for the sample (no auth or CRUD routes) should be the template")
+		return 1
+	}
+	fmt.Println("✅ PASS  user journey written from the generic template")
+	return 0
+}
+
+// SelfTest_checkProgressChart verifies that the sample's single CreateUser shows as a partial CRUD phase
+func SelfTest_checkProgressChart(outDir string, structure *ProjectStructure) int {
+	if err := Theory2Reality_WriteProgressChart(outDir, structure); err != nil {
+		fmt.Printf("❌ FAIL  progress chart: %v\n", err)
+		return 1
+	}
+	data, err := os.ReadFile(filepath.Join(outDir, "Theory2Reality_progress_chart.mmd.md"))
+	if err != nil || !strings.Contains(string(data), "pie showData") || !strings.Contains(string(data), "| 3. CRUD Routes | 33% |") {
+		fmt.Println("❌ FAIL  progress chart should hold a pie and a 33% CRUD phase (1 of 3 operations)")
+		return 1
+	}
+	fmt.Println("✅ PASS  progress chart with partial phase completion")
+	return 0
+}
+
+// SelfTest_checkManifest verifies that manifest.json is sorted, leaves itself out and is
+// byte-identical when written twice over the same output
+func SelfTest_checkManifest(outDir string) int {
+	read := func() ([]byte, error) {
+		if _, err := writeManifest(outDir); err != nil {
+			return nil, err
+		}
+		return os.ReadFile(filepath.Join(outDir, manifestName))
+	}
+	first, err := read()
+	if err != nil {
+		fmt.Printf("❌ FAIL  write manifest: %v\n", err)
+		return 1
+	}
+	second, err := read()
+	if err != nil {
+		fmt.Printf("❌ FAIL  rewrite manifest: %v\n", err)
+		return 1
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(first, &manifest); err != nil {
+		fmt.Printf("❌ FAIL  parse manifest: %v\n", err)
+		return 1
+	}
+	sorted := sort.SliceIsSorted(manifest.Files, func(i, j int) bool { return manifest.Files[i].Path < manifest.Files[j].Path })
+	for _, entry := range manifest.Files {
+		if entry.Path == manifestName || len(entry.SHA256) != 64 {
+			sorted = false
+		}
+	}
+	if len(manifest.Files) == 0 || !sorted || !bytes.Equal(first, second) {
+		fmt.Printf("❌ FAIL  manifest: %d files, sorted and valid=%v, stable=%v\n", len(manifest.Files), sorted, bytes.Equal(first, second))
+		return 1
+	}
+	fmt.Printf("✅ PASS  manifest lists %d output files, sorted and stable\n", len(manifest.Files))
+	return 0
+}
+
+// SelfTest_writeSample copies the embedded sample module to root, stripping the .txt suffix
+func SelfTest_writeSample(root string) error {
+	return fs.WalkDir(selfTestSample, "selftest_sample", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(path, "selftest_sample"), "/")
+		target := filepath.Join(root, filepath.FromSlash(strings.TrimSuffix(rel, ".txt")))
+		if d.IsDir() {
+			return ensureDir(target)
+		}
+		data, err := selfTestSample.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, 0644)
+	})
+}
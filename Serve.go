@@ -0,0 +1,215 @@
+/*===============================================================================
+🐦 ::: PhoenixFlix - Multi-Purpose Movies & Christian Streaming Platform :::
+🔥 with dual database architecture, WebAuthn authentication, and family-friendly streaming experience.
+===============================================================================
+Author: Ben Tran (https://github.com/thephoenixflix)
+Email: thephoenixflix@gmail.com
+Website: https://bit.ly/thephoenixflix
+===============================================================================*/
+
+/*
+===============================================================================
+SERVE - LIVE RELOADING CHARTS WHILE YOU CODE
+===============================================================================
+
+Author: Ben Tran
+Date: 15/10/2026
+Description: -serve generates once, serves the output directory over HTTP and
+             opens index.html in the browser exactly once. It then watches the
+             project's .go, .sql and go.mod files; on a change the pipeline
+             runs again without opening anything and every open tab reloads
+             itself. The reload is pushed over a small Server-Sent Events
+             endpoint (/__livereload) whose script is injected into each
+             served HTML page, so the files on disk stay untouched.
+
+TO USE THIS FILE:
+1. go run -tags flowcharts . -serve localhost:8080
+2. Edit code - the open tab refreshes after each regeneration
+3. Ctrl+C to stop
+
+NOTES:
+- One browser tab for the whole session instead of one per chart per run
+- The output directory, hidden folders, vendor/ and -exclude-dir matches are not watched
+
+===============================================================================
+*/
+
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// servePollInterval is how often the watched files are checked for changes
+const servePollInterval = time.Second
+
+// liveReloadScript is injected before </body> of every served HTML page
+const liveReloadScript = `<script>new EventSource("/__livereload").onmessage = function () { location.reload(); };</script>`
+
+// liveReload fans a reload event out to every connected browser tab
+type liveReload struct {
+	mu      sync.Mutex
+	clients map[chan struct{}]bool
+}
+
+// notify asks every connected tab to reload; a tab that has not read its last event yet is skipped
+func (lr *liveReload) notify() {
+	lr.mu.Lock()
+	defer lr.mu.Unlock()
+	for ch := range lr.clients {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// ServeHTTP keeps an event stream open and writes one message per reload
+func (lr *liveReload) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+	ch := make(chan struct{}, 1)
+	lr.mu.Lock()
+	lr.clients[ch] = true
+	lr.mu.Unlock()
+	defer func() {
+		lr.mu.Lock()
+		delete(lr.clients, ch)
+		lr.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	flusher.Flush()
+	for {
+		select {
+		case <-ch:
+			fmt.Fprint(w, "data: reload\n\n")
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// serveOutput serves outDir, injecting the live reload script into HTML pages
+func serveOutput(outDir string) http.Handler {
+	files := http.FileServer(http.Dir(outDir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := path.Clean("/" + r.URL.Path)
+		if name == "/" {
+			name = "/index.html"
+		}
+		if !strings.HasSuffix(name, ".html") {
+			files.ServeHTTP(w, r)
+			return
+		}
+		data, err := os.ReadFile(filepath.Join(outDir, filepath.FromSlash(name)))
+		if err != nil {
+			files.ServeHTTP(w, r)
+			return
+		}
+		page := string(data)
+		if i := strings.LastIndex(page, "</body>"); i >= 0 {
+			page = page[:i] + liveReloadScript + "\n" + page[i:]
+		} else {
+			page += liveReloadScript
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-cache")
+		fmt.Fprint(w, page)
+	})
+}
+
+// watchFingerprint summarises the watched files under projectRoot; it changes when one is added, removed or saved
+func watchFingerprint(projectRoot, outAbs string) string {
+	var count int
+	var size, latest int64
+	filepath.WalkDir(projectRoot, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if p == outAbs || Existing_skipScanDir(projectRoot, p, d.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(p, ".go") && !strings.HasSuffix(p, ".sql") && d.Name() != "go.mod" {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		count++
+		size += info.Size()
+		if t := info.ModTime().UnixNano(); t > latest {
+			latest = t
+		}
+		return nil
+	})
+	return fmt.Sprintf("%d/%d/%d", count, size, latest)
+}
+
+// runServeMode generates the charts, serves them on addr and regenerates with a live reload on every change
+func runServeMode(root, projectRoot, outAbs, addr string, opts FlowchartOptions) error {
+	// The browser is opened once below; regenerations only reload the open tab
+	opts.SkipOpen = true
+	if err := BTFlowcharts(root, outAbs, opts); err != nil {
+		return fmt.Errorf("initial generation: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("serve %s: %w", addr, err)
+	}
+	lr := &liveReload{clients: make(map[chan struct{}]bool)}
+	mux := http.NewServeMux()
+	mux.Handle("/__livereload", lr)
+	mux.Handle("/", serveOutput(outAbs))
+	go http.Serve(listener, mux)
+
+	// A wildcard listen address (":8080") is opened in the browser as localhost
+	host := listener.Addr().String()
+	if tcp, ok := listener.Addr().(*net.TCPAddr); ok && tcp.IP.IsUnspecified() {
+		host = fmt.Sprintf("localhost:%d", tcp.Port)
+	}
+	pageURL := "http://" + host + "/index.html"
+	fmt.Printf("🌐 Serving %s at %s (Ctrl+C to stop)\n", outAbs, pageURL)
+	exec.Command("cmd", "/c", "start", pageURL).Start()
+
+	fmt.Printf("👀 Watching %s for changes\n", projectRoot)
+	last := watchFingerprint(projectRoot, outAbs)
+	for {
+		time.Sleep(servePollInterval)
+		current := watchFingerprint(projectRoot, outAbs)
+		if current == last {
+			continue
+		}
+		last = current
+		fmt.Printf("\n🔄 Change detected at %s - regenerating\n", time.Now().Format("15:04:05"))
+		if err := BTFlowcharts(root, outAbs, opts); err != nil {
+			// Keep serving the previous charts; the next save gets another try
+			fmt.Printf("⚠️  Regeneration failed: %v\n", err)
+			continue
+		}
+		// Files written during the run must not trigger another one
+		last = watchFingerprint(projectRoot, outAbs)
+		lr.notify()
+		fmt.Println("✅ Charts regenerated - open tabs reloaded")
+	}
+}
@@ -29,6 +29,7 @@ FEATURES:
 - Theory2Reality_gap_analysis.mmd.md - What you still need to implement
 - Theory2Reality_next_steps.mmd.md - Recommended next actions
 - Theory2Reality_implementation_status.mmd.md - Detailed status breakdown
+- Theory2Reality_progress_chart.mmd.md - Pie and bar chart of the phases done
 
 ===============================================================================
 */
@@ -51,6 +52,11 @@ func Theory2Reality_WriteAllAnalysis(outDir string, structure *ProjectStructure)
 		return fmt.Errorf("failed to write progress analysis: %w", err)
 	}
 
+	// Generate progress chart
+	if err := Theory2Reality_WriteProgressChart(outDir, structure); err != nil {
+		return fmt.Errorf("failed to write progress chart: %w", err)
+	}
+
 	// Generate gap analysis
 	if err := Theory2Reality_WriteGapAnalysis(outDir, structure); err != nil {
 		return fmt.Errorf("failed to write gap analysis: %w", err)
@@ -154,6 +160,78 @@ func Theory2Reality_WriteProgressAnalysis(outDir string, structure *ProjectStruc
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
+// theoryPhase is one phase of the instructor's model and how much of it the scan found
+type theoryPhase struct {
+	Name    string
+	Percent int // 0-100
+}
+
+// Theory2Reality_phaseCompletion returns the completion of the six phases: 0 or 100 from the
+// yes/no detectors, and a partial value for the CRUD phase, which counts operations towards the 3 it needs
+func Theory2Reality_phaseCompletion(structure *ProjectStructure) []theoryPhase {
+	done := func(ok bool) int {
+		if ok {
+			return 100
+		}
+		return 0
+	}
+	crud := min(crudOperationCount(structure), crudOperationsNeeded) * 100 / crudOperationsNeeded
+	return []theoryPhase{
+		{"Scaffolding", done(hasBasicServer(structure))},
+		{"Data Layer", done(hasDatabaseLayer(structure))},
+		{"CRUD Routes", crud},
+		{"Testing", done(hasTestingSetup(structure))},
+		{"Authentication", done(hasAuthentication(structure))},
+		{"Middleware", done(hasMiddlewareLayer(structure))},
+	}
+}
+
+// Theory2Reality_WriteProgressChart draws the progress against the theory model as charts: a pie of
+// completed vs remaining phases and a bar per phase, where partial signals show as partial bars
+func Theory2Reality_WriteProgressChart(outDir string, structure *ProjectStructure) error {
+	phases := Theory2Reality_phaseCompletion(structure)
+	completed := 0
+	var labels, values []string
+	for i, phase := range phases {
+		if phase.Percent == 100 {
+			completed++
+		}
+		labels = append(labels, fmt.Sprintf("\"%d %s\"", i+1, phase.Name))
+		values = append(values, fmt.Sprint(phase.Percent))
+	}
+	remaining := len(phases) - completed
+
+	var b strings.Builder
+	b.WriteString("# Theory to Reality: Progress Chart\n\n")
+	b.WriteString(fmt.Sprintf("**%d of %d phases complete (%d%%).** ", completed, len(phases), completed*100/len(phases)))
+	b.WriteString("Phases the detectors only partly recognise show as partial bars.\n\n")
+	b.WriteString("```mermaid\n")
+	b.WriteString("pie showData\n")
+	b.WriteString("    title Phases of the theory model\n")
+	if completed > 0 {
+		b.WriteString(fmt.Sprintf("    \"Completed\" : %d\n", completed))
+	}
+	if remaining > 0 {
+		b.WriteString(fmt.Sprintf("    \"Remaining\" : %d\n", remaining))
+	}
+	b.WriteString("```\n\n")
+	b.WriteString("```mermaid\n")
+	b.WriteString("xychart-beta\n")
+	b.WriteString("    title \"Completion per phase\"\n")
+	b.WriteString("    x-axis [" + strings.Join(labels, ", ") + "]\n")
+	b.WriteString("    y-axis \"Completed %\" 0 --> 100\n")
+	b.WriteString("    bar [" + strings.Join(values, ", ") + "]\n")
+	b.WriteString("```\n\n")
+	b.WriteString("| Phase | Completion |\n")
+	b.WriteString("|-------|------------|\n")
+	for i, phase := range phases {
+		b.WriteString(fmt.Sprintf("| %d. %s | %d%% |\n", i+1, phase.Name, phase.Percent))
+	}
+
+	path := filepath.Join(outDir, "Theory2Reality_progress_chart.mmd.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
+
 // Theory2Reality_WriteGapAnalysis creates a diagram showing what you still need to implement
 func Theory2Reality_WriteGapAnalysis(outDir string, structure *ProjectStructure) error {
 	content := "```mermaid\n" +
@@ -397,9 +475,9 @@ func Theory2Reality_WriteImplementationStatus(outDir string, structure *ProjectS
 	return os.WriteFile(path, []byte(content), 0644)
 }
 
-// Helper functions to analyze project structure
+// Helper functions to analyze project structure (vendored code does not count towards progress)
 func hasBasicServer(structure *ProjectStructure) bool {
-	for _, fn := range structure.Functions {
+	for _, fn := range Existing_ownFunctions(structure) {
 		if strings.Contains(strings.ToLower(fn.Name), "main") &&
 			strings.Contains(strings.ToLower(fn.Name), "server") {
 			return true
@@ -409,7 +487,7 @@ func hasBasicServer(structure *ProjectStructure) bool {
 }
 
 func hasDatabaseLayer(structure *ProjectStructure) bool {
-	for _, fn := range structure.Functions {
+	for _, fn := range Existing_ownFunctions(structure) {
 		if strings.Contains(strings.ToLower(fn.Name), "db") ||
 			strings.Contains(strings.ToLower(fn.Name), "database") ||
 			strings.Contains(strings.ToLower(fn.Name), "migrate") {
@@ -419,9 +497,17 @@ func hasDatabaseLayer(structure *ProjectStructure) bool {
 	return false
 }
 
+// crudOperationsNeeded is how many CRUD operations complete the CRUD phase
+const crudOperationsNeeded = 3
+
 func hasCRUDOperations(structure *ProjectStructure) bool {
+	return crudOperationCount(structure) >= crudOperationsNeeded
+}
+
+// crudOperationCount counts the functions named like a create, read, update or delete operation
+func crudOperationCount(structure *ProjectStructure) int {
 	crudCount := 0
-	for _, fn := range structure.Functions {
+	for _, fn := range Existing_ownFunctions(structure) {
 		if strings.Contains(strings.ToLower(fn.Name), "create") ||
 			strings.Contains(strings.ToLower(fn.Name), "read") ||
 			strings.Contains(strings.ToLower(fn.Name), "update") ||
@@ -429,11 +515,11 @@ func hasCRUDOperations(structure *ProjectStructure) bool {
 			crudCount++
 		}
 	}
-	return crudCount >= 3 // Need at least 3 CRUD operations
+	return crudCount
 }
 
 func hasTestingSetup(structure *ProjectStructure) bool {
-	for _, fn := range structure.Functions {
+	for _, fn := range Existing_ownFunctions(structure) {
 		if strings.Contains(strings.ToLower(fn.Name), "test") {
 			return true
 		}
@@ -442,7 +528,7 @@ func hasTestingSetup(structure *ProjectStructure) bool {
 }
 
 func hasAuthentication(structure *ProjectStructure) bool {
-	for _, fn := range structure.Functions {
+	for _, fn := range Existing_ownFunctions(structure) {
 		if strings.Contains(strings.ToLower(fn.Name), "auth") ||
 			strings.Contains(strings.ToLower(fn.Name), "token") ||
 			strings.Contains(strings.ToLower(fn.Name), "jwt") ||
@@ -454,7 +540,7 @@ func hasAuthentication(structure *ProjectStructure) bool {
 }
 
 func hasMiddlewareLayer(structure *ProjectStructure) bool {
-	for _, fn := range structure.Functions {
+	for _, fn := range Existing_ownFunctions(structure) {
 		if strings.Contains(strings.ToLower(fn.Name), "middleware") ||
 			strings.Contains(strings.ToLower(fn.Name), "auth") {
 			return true
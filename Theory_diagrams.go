@@ -36,6 +36,7 @@ FEATURES:
 - LessonModel_build_sequence.mmd.md - Step-by-step build order
 - LessonModel_learning_phases.mmd.md - Learning phases and milestones
 - LessonModel_project_scaffolding.mmd.md - How to scaffold from scratch
+- LessonModel_git_graph.mmd.md - The phases as a gitGraph, one branch per phase
 
 ===============================================================================
 */
@@ -46,6 +47,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // LessonModel_WriteAllLessonDiagrams generates all lesson-based diagrams following instructor's progression
@@ -72,6 +74,11 @@ func LessonModel_WriteAllLessonDiagrams(outDir string) error {
 		return fmt.Errorf("failed to write project scaffolding diagram: %w", err)
 	}
 
+	// Generate the version-control view of the phases
+	if err := LessonModel_WriteGitGraphDiagram(outDir); err != nil {
+		return fmt.Errorf("failed to write git graph diagram: %w", err)
+	}
+
 	fmt.Println("�� Lesson Model diagrams generated successfully!")
 	return nil
 }
@@ -337,3 +344,49 @@ func LessonModel_WriteProjectScaffoldingDiagram(outDir string) error {
 	path := filepath.Join(outDir, "LessonModel_project_scaffolding.mmd.md")
 	return os.WriteFile(path, []byte(content), 0644)
 }
+
+// lessonModelPhase is one phase of the instructor's course with its sub-steps in order
+type lessonModelPhase struct {
+	Branch string
+	Title  string
+	Steps  []string
+}
+
+// lessonModelPhases are the seven phases of LessonModel_WriteInstructorProgressionDiagram
+var lessonModelPhases = []lessonModelPhase{
+	{"phase1-scaffolding", "Phase 1: Project Scaffolding", []string{"Creating the Go Project", "Creating an HTTP Server", "Parsing Command-Line Flags", "Chi Router", "API Route Handlers"}},
+	{"phase2-data-layer", "Phase 2: Data Layer", []string{"Postgres Database Docker Container", "pgx Driver for PostgreSQL", "SQL Migrations with Goose", "Running Goose Migrations", "Defining Data Types in Store", "CreateWorkout Query"}},
+	{"phase3-crud-routes", "Phase 3: API CRUD Routes", []string{"CreateWorkout Handler", "Testing CreateWorkout Endpoint with cURL", "Getting Workouts By ID", "Updating Workouts", "Handlers for Getting and Updating Workouts", "Deleting Workouts", "JSON Response Writer Refactor", "Logging and JSON Error Responses"}},
+	{"phase4-testing", "Phase 4: Testing Go Applications", []string{"Using a Testing Database", "Connecting to the Test Database", "Testing CreateWorkout Success", "Testing CreateWorkout Errors", "Running Tests in Go"}},
+	{"phase5-authentication", "Phase 5: Authentication", []string{"Managing User Data", "User SQL Queries", "Validating User Data", "Register User API", "Hashing and Storing User Passwords", "Token Authentication and OAuth 2.0", "Creating a Tokens Table", "Generating JSON Web Tokens", "Token API Handlers", "Testing the Authentication Routes"}},
+	{"phase6-middleware", "Phase 6: Middleware", []string{"Getting User Tokens", "Modifying Request Context", "Authentication Middleware", "Protecting Routes with Middleware", "Adding User ID Migration", "Validating User Workout Ownership", "Testing API Endpoints"}},
+	{"phase7-wrapping-up", "Phase 7: Wrapping Up", []string{"Wrapping Up"}},
+}
+
+// LessonModel_WriteGitGraphDiagram renders the phases as a Mermaid gitGraph: each phase is a branch
+// with one commit per sub-step, merged back into main with a tag
+func LessonModel_WriteGitGraphDiagram(outDir string) error {
+	if !mermaidSupports("gitGraph") {
+		fmt.Printf("ℹ️  Skipping lesson git graph (needs Mermaid %s+, pinned %s)\n", mermaidFeatureMinVersion["gitGraph"], mermaidVersion)
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString("```mermaid\n")
+	b.WriteString("gitGraph\n")
+	b.WriteString("    commit id: \"Course start\"\n")
+	for i, phase := range lessonModelPhases {
+		b.WriteString(fmt.Sprintf("    branch %s\n", phase.Branch))
+		b.WriteString(fmt.Sprintf("    checkout %s\n", phase.Branch))
+		for j, step := range phase.Steps {
+			// Commit ids must be unique across the graph, so they carry the step number
+			b.WriteString(fmt.Sprintf("    commit id: \"%d.%d %s\"\n", i+1, j+1, strings.ReplaceAll(step, "\"", "'")))
+		}
+		b.WriteString("    checkout main\n")
+		b.WriteString(fmt.Sprintf("    merge %s tag: \"%s\"\n", phase.Branch, phase.Title))
+	}
+	b.WriteString("```\n")
+
+	path := filepath.Join(outDir, "LessonModel_git_graph.mmd.md")
+	return os.WriteFile(path, []byte(b.String()), 0644)
+}
@@ -0,0 +1,41 @@
+// Minimal squarified treemap layout, embedded with -offline in place of D3.
+// btTreemap(items, width, height) lays out items ({value, ...}) and returns
+// rectangles {x0, y0, x1, y1, data}, the same shape as d3.treemap leaves.
+function btTreemap(items, width, height) {
+  var nodes = items.filter(function (d) { return d.value > 0; })
+    .sort(function (a, b) { return b.value - a.value; });
+  var total = nodes.reduce(function (sum, d) { return sum + d.value; }, 0);
+  var rects = [];
+  if (!total) return rects;
+
+  var scale = (width * height) / total;
+  var x = 0, y = 0, w = width, h = height, i = 0;
+  while (i < nodes.length) {
+    // Grow a row along the shorter side while the worst aspect ratio improves
+    var side = Math.min(w, h), row = [], rowArea = 0, best = Infinity;
+    while (i < nodes.length) {
+      var area = nodes[i].value * scale;
+      var sum = rowArea + area;
+      var largest = row.length ? row[0].area : area;
+      var worst = Math.max(side * side * largest / (sum * sum), (sum * sum) / (side * side * area));
+      if (worst > best) break;
+      best = worst;
+      row.push({ data: nodes[i], area: area });
+      rowArea = sum;
+      i++;
+    }
+
+    var thickness = rowArea / side, offset = 0;
+    row.forEach(function (r) {
+      var length = r.area / thickness;
+      if (w >= h) {
+        rects.push({ x0: x, y0: y + offset, x1: x + thickness, y1: y + offset + length, data: r.data });
+      } else {
+        rects.push({ x0: x + offset, y0: y, x1: x + offset + length, y1: y + thickness, data: r.data });
+      }
+      offset += length;
+    });
+    if (w >= h) { x += thickness; w -= thickness; } else { y += thickness; h -= thickness; }
+  }
+  return rects;
+}